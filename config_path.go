@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// configPath returns --config if given, otherwise
+// $XDG_CONFIG_HOME/mtzdate/config.toml if it exists, otherwise "".
+func configPath() string {
+	if path, ok := args["--config"].(string); ok {
+		return path
+	}
+
+	dir, ok := os.LookupEnv("XDG_CONFIG_HOME")
+	if !ok || dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+
+	path := filepath.Join(dir, "mtzdate", "config.toml")
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+
+	return path
+}
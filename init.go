@@ -14,8 +14,15 @@ func init() {
 
 	usage := `Usage:
   ` + prog + ` (-h | --version)
-  ` + prog + `
-  ` + prog + ` --loop
+  ` + prog + ` [--config PATH] [--strict] [--at TIME] [--ref ZONE] [--dst N] [--layout LAYOUT]
+      [--output FORMAT | --template TMPL | --ruler] [--loop] [--color WHEN]
+  ` + prog + ` meet [--config PATH] [--strict] [--from TIME] [--days N] [--duration DUR] [--step DUR]
+      [--required ZONES] [--optional ZONES] [--top N] [--color WHEN]
+  ` + prog + ` zones [<query>]
+  ` + prog + ` transitions [--config PATH] [--days N]
+  ` + prog + ` convert <time> [--config PATH] [--strict] [--from ZONE] [--to ZONES]
+      [--layout LAYOUT] [--output FORMAT | --template TMPL] [--color WHEN]
+  ` + prog + ` doctor [--config PATH] [--color WHEN]
 
 Description
   This command-line utility displays Unix date in multiple time zones
@@ -24,9 +31,56 @@ Description
   With MTZDATE_LOOP=1 or --loop, ` + prog + ` will refresh the screen once a second.
   Control-C will break the loop.
 
+  On a terminal, the loop is interactive: the left and right arrows move the displayed time by an hour, shifted by 15 minutes, and the up and down arrows by a day; n goes back to now, tab moves the highlighted column, / adds a time zone or city (e.g. /new york) and q quits.
+
+  With --at, ` + prog + ` shows another instant instead of now, with workhours colored for that instant. TIME may be RFC 3339, Unix seconds after an @ (@1700000000), or a combination of a day (today, tomorrow, yesterday, [next] Tuesday, 2006-01-02), a time of day (15:00), an offset (+90m) and a time zone or city from MTZDATE_TIMEZONES:
+
+  ` + prog + ` --at 'next Tuesday 15:00 Europe/Paris'
+  ` + prog + ` --at '15:00 München'
+
+  With --ruler, ` + prog + ` shows each zone as a row of 24 hours colored by workhours, from midnight in the local time zone (or --ref) to the next, with the hours of all zones lined up and the current hour marked:
+
+  ` + prog + ` --ruler --ref München
+
+  ` + prog + ` meet lists the best windows to start a meeting within the next --days, scoring each zone by the worst band the meeting touches: green beats yellow beats off-day beats faint. Every zone but UTC is required to be in green or yellow hours unless --required or --optional say otherwise.
+
+  ` + prog + ` zones lists the time zones in $ZONEINFO, /usr/share/zoneinfo and Go's tzdata whose name, city, abbreviation, country code or country name matches the query, closely or loosely, with their current time, UTC offset and flag:
+
+  ` + prog + ` zones kathmandu
+  ` + prog + ` zones JST
+  ` + prog + ` zones germany
+
+  ` + prog + ` transitions lists the UTC offset changes of the zones in MTZDATE_TIMEZONES within the next --days, earliest first, with the wall clock on either side, so that the weeks when only some zones have switched stand out:
+
+  ` + prog + ` transitions --days 180
+
+  ` + prog + ` convert reads <time> as the wall clock in the --from time zone or city, e.g. a time from an invitation, and shows it there and in the --to zones, or else in MTZDATE_TIMEZONES, with their flags and workhours. A time that a change of UTC offset repeats is read as the first of the two, and one that it skips as if the clocks had not changed yet, i.e. moved forward by the gap; either way ` + prog + ` says so:
+
+  ` + prog + ` convert '2026-11-03 09:30' --from München --to 東京,Chicago
+
+  ` + prog + ` doctor prints the effective configuration after the file and environment are merged, the time zone database in use and its release, what the terminal seems able to show (colors, emoji), and every configuration problem that the other commands would only warn about.
+
 Options:
   -h, --help
-  -l, --loop    # Loop until Control-C is trapped
+  -a, --at TIME        # Show TIME instead of now
+  -c, --config PATH    # Read settings from PATH
+  -C, --color WHEN     # auto, always or never; auto colors a terminal unless NO_COLOR is set
+  -D, --dst N          # Show offset changes within N days; see MTZDATE_DST_DAYS
+  -L, --layout LAYOUT  # Go layout or strftime pattern of the date; see MTZDATE_TIME_LAYOUT
+  -l, --loop           # Loop until Control-C is trapped
+  -o, --output FORMAT  # text, json, csv or tsv [default: text]
+  -R, --ruler          # Show 24 hours per zone, lined up across zones
+  -r, --ref ZONE       # Zone the "o" column is relative to; see MTZDATE_REF
+  -s, --strict         # Exit on configuration mistakes instead of working around them
+  -t, --template TMPL  # Go template for each zone; see MTZDATE_TEMPLATE
+  --from WHEN          # meet: earliest start, now by default; convert: zone of <time>
+  --to ZONES           # Comma-separated zones to convert to; MTZDATE_TIMEZONES by default
+  --days N             # Number of days to search; 7 for meet, 365 for transitions
+  --duration DUR       # Meeting length [default: 30m]
+  --step DUR           # Granularity of the search [default: 15m]
+  --required ZONES     # Comma-separated zones that must be in workhours
+  --optional ZONES     # Comma-separated zones that may be out of workhours
+  --top N              # Number of windows to list [default: 5]
   --version
 
 Installation
@@ -35,7 +89,13 @@ Installation
 Environment
   Set MTZDATE_TIMEZONES to a comma-separated list of time zones. If desired, preface each time zone with a UTF-8-encoded city name or alias and a colon.
 
-  To see emoji flags, set MTZDATE_FLAGS to a comma-separated map of UTF-8-encoded city names or aliases followd by two-letter country code -- separated by a colon.
+  A city that is not a time zone name, e.g. MTZDATE_TIMEZONES='Paris,Bangalore,Austin', is looked up in a built-in table of cities and in the cities of the time zone database, and brings its country's flag along. When a name fits several places, the most populous wins and a warning names the others; name the time zone, e.g. Portland:America/New_York, to choose.
+
+  A time zone may be followed by its own workdays and green hours after an "@", e.g. Tel Aviv:Asia/Jerusalem@Sun-Thu/9-18 or Bangalore:Asia/Kolkata@/10-19. Weekday ranges are joined with "+", e.g. @Mon-Wed+Fri, and the yellow hours become the hour on either side of the green hours.
+
+  Each time zone shows the flag of the country that the time zone database's zone.tab puts it in, e.g. 🇫🇷 for Europe/Paris, unless its label names a country.
+
+  To see other emoji flags, set MTZDATE_FLAGS to a comma-separated map of UTF-8-encoded city names or aliases followd by two-letter country code -- separated by a colon. Any ISO 3166-1 code or country name works, as do subdivision codes: GB-ENG, GB-SCT and GB-WLS (drawn by fewer fonts) and others such as US-CA, which get the country's flag; anything else, e.g. Office:🏢 or Home:hq, is shown as it is.
 
   ` + prog + ` defaults to coloring workhours green and to coloring pre- and post-workhours yellow. The behavior is controlled by the following environment variables (with their default values):
 
@@ -44,15 +104,62 @@ Environment
   export MTZDATE_YELLOW_HOURS='7-8,17-18'
   export MTZDATE_FAINT_HOURS='0-7,22-24'
 
+  Hours may be given to the minute, e.g. MTZDATE_GREEN_HOURS='08:30-17:30', and a range may wrap past midnight for night shifts, e.g. MTZDATE_GREEN_HOURS='22:00-06:00'; the hours after midnight then count toward the previous workday. Unless they are set too, the yellow hours then become the hour before and the hour after the green hours, and the faint hours leave both out.
+
+  MTZDATE_THEME picks the colors of the bands: default (the terminal's green, yellow and faint), light (darker shades for light backgrounds), high-contrast (black on bright backgrounds) or colorblind-safe (blue and orange). MTZDATE_GREEN_STYLE, MTZDATE_YELLOW_STYLE, MTZDATE_FAINT_STYLE and MTZDATE_HIGHLIGHT_STYLE override the theme band by band with attributes (bold, faint, italic, underline, blink, reverse, strike), a foreground color and a bg= background color, each one of the 16 terminal colors, e.g. bright-green, a number of the 256-color palette or #rrggbb in 24-bit color:
+
+  export MTZDATE_THEME='light'
+  export MTZDATE_GREEN_STYLE='bold fg=#1b5e20 bg=194'
+
+  Colors are shown on a terminal unless NO_COLOR is set; --color always or --color never decides instead.
+
+  Zones tagged with a country -- by their label or by MTZDATE_FLAGS -- treat that country's public holidays as days off and show the holiday's name. Calendars for DE, FR, JP and US are built in; set MTZDATE_HOLIDAYS to a comma-separated list of JSON or iCalendar files named after a country code (e.g. JP.json, US.ics), or of directories holding them, to add more. A JSON calendar looks like [{"date": "2026-01-01", "name": "New Year's Day"}].
+
   To opt out of the feature, set MTZDATE_WORKDAYS='':
 
   export MTZDATE_WORKDAYS=''
 
-  MTZDATE_FORMAT can be set to a sequence of "d" (date), "f" (flag) and "c" (city) to signify the display format. (If unset, "dfc" is assumed.) Naturally, it's most meaningful if it's three letters, but there are no restrictions.
+  MTZDATE_FORMAT can be set to a sequence of "d" (date), "f" (flag), "c" (city), "o" (offset) and "t" (transition) to signify the display format. (If unset, "dfc" is assumed.) Naturally, it's most meaningful if it's three letters, but there are no restrictions.
+
+  The "d" column follows MTZDATE_TIME_LAYOUT or --layout, either a Go time layout (https://golang.org/pkg/time/#pkg-constants) or a strftime pattern, told apart by the "%". If unset, "Mon Jan _2 15:04:05 MST" is assumed. The time of day is colored by workhours whatever the layout, e.g. a 12-hour clock without seconds and an ISO date:
+
+  export MTZDATE_TIME_LAYOUT='2006-01-02 3:04PM MST'
+  export MTZDATE_TIME_LAYOUT='%F %I:%M%p %Z'
+
+  MTZDATE_LOCALE names the weekdays and months of the "d" column in another language and, unless MTZDATE_TIME_LAYOUT is set, orders it as usual there. A zone may have its own after an "@", before or after its schedule, e.g. 東京:Asia/Tokyo@ja shows 木 7月 30 10:10:00 JST and München:Europe/Berlin@de shows Do. 30. Juli 03:10:00 CEST. The names come from the Unicode CLDR, bundled for de, en, en-GB, es, fr, hi, it, ja, ko, nl, pl, pt, ru, sv, tr and zh.
+
+  The "o" column shows how far ahead or behind each zone is, e.g. +9h, -5:30 or same, with +1d or -1d when the date differs. It is relative to the local time zone unless MTZDATE_REF or --ref names another.
+
+  The "t" column warns of a zone's next UTC offset change, e.g. DST→ in 3d, -1h, when it is due within MTZDATE_DST_DAYS days (7 by default). --dst N adds the column and looks N days ahead.
+
+  For full control, set MTZDATE_TEMPLATE or --template to a Go text/template (https://golang.org/pkg/text/template/), executed once per zone with the fields .Label, .Name, .Flag, .Country, .Abbrev, .Offset, .Time, .Workday, .Band and .Holiday, and the helpers fmt (Go time layout), color (by band), offset (+9h), pad (to a display width), upper and lower:
+
+  export MTZDATE_TEMPLATE='{{.Flag}} {{pad 6 .Label}} {{color .Band (fmt "15:04" .Time)}} ({{offset .Time}})'
+
+  Mistakes in the settings -- an unknown time zone, city, country, weekday or format letter, or a malformed or overlapping hour range -- are reported together as warnings that name the variable and, where a close match exists, suggest a fix, e.g. MTZDATE_GREEN_HOURS: "8-l7": bad time of day "l7": want H or HH:MM; did you mean "8-17"? ` + prog + ` carries on with UTC or the setting's previous value; with --strict it exits with status 1 instead.
+
+Configuration File
+  The same settings can be kept in a TOML file, read from --config PATH or else from $XDG_CONFIG_HOME/mtzdate/config.toml (~/.config/mtzdate/config.toml). Keys are the environment variables in lower case without the MTZDATE_ prefix; zones may be listed as tables:
+
+  format   = "dfc"
+  workdays = ["Mon", "Tue", "Wed", "Thu", "Fri"]
+
+  [[zones]]
+  label  = "München"
+  zone   = "Europe/Berlin"
+  flag   = "DE"
+  locale = "de"
+
+  [[zones]]
+  label    = "Tel Aviv"
+  zone     = "Asia/Jerusalem"
+  workdays = "Sun-Thu"
+  hours    = "9-18"
+
+  Command-line options take precedence over environment variables, which take precedence over the file, which takes precedence over the built-in defaults.
 
 Examples
   $ export MTZDATE_TIMEZONES='America/Chicago,Europe/Paris'
-  $ export MTZDATE_FLAGS='Chicago:US,Paris:FR'
 
   $ date
   Sun Jul 29 18:10:33 PDT 2018
@@ -107,572 +214,13 @@ See Also
 		version,
 	)
 	die(err)
-
-	if args["--loop"].(bool) {
-		err := os.Setenv("MTZDATE_LOOP", "1")
-		die(err)
-	}
-
-	_, set := os.LookupEnv("MTZDATE_FORMAT")
-	if !set {
-		err := os.Setenv("MTZDATE_FORMAT", mtzdateFormat)
-		die(err)
-	}
 }
 
-const (
-	utc                = "UTC"
-	mtzdateWorkdays    = "Mon,Tue,Wed,Thu,Fri"
-	mtzdateGreenHours  = "8-17"
-	mtzdateYellowHours = "7-8,17-18"
-	mtzdateFaintHours  = "0-7,22-24"
-	mtzdateFormat      = "dfc"
-)
-
 var (
 	args docopt.Opts
 
 	prog    = os.Args[0]
 	version = "1.0.0"
 
-	bold   = color.New(color.Bold).SprintFunc()
-	green  = color.New(color.FgGreen).SprintFunc()
-	yellow = color.New(color.FgYellow).SprintFunc()
-	faint  = color.New(color.Faint).SprintFunc()
-
-	workday     map[string]bool
-	greenHours  [][]int
-	yellowHours [][]int
-	faintHours  [][]int
-
-	// https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
-	countryCode = map[string]string{
-		"AF": "Afghanistan",
-		"AX": "Åland Islands",
-		"AL": "Albania",
-		"DZ": "Algeria",
-		"AS": "American Samoa",
-		"AD": "Andorra",
-		"AO": "Angola",
-		"AI": "Anguilla",
-		"AQ": "Antarctica",
-		"AG": "Antigua & Barbuda",
-		"AR": "Argentina",
-		"AM": "Armenia",
-		"AW": "Aruba",
-		"AC": "Ascension Island",
-		"AU": "Australia",
-		"AT": "Austria",
-		"AZ": "Azerbaijan",
-		"BS": "Bahamas",
-		"BH": "Bahrain",
-		"BD": "Bangladesh",
-		"BB": "Barbados",
-		"BY": "Belarus",
-		"BE": "Belgium",
-		"BZ": "Belize",
-		"BJ": "Benin",
-		"BM": "Bermuda",
-		"BT": "Bhutan",
-		"BO": "Bolivia",
-		"BA": "Bosnia & Herzegovina",
-		"BW": "Botswana",
-		"BV": "Bouvet Island",
-		"BR": "Brazil",
-		"IO": "British Indian Ocean Territory",
-		"VG": "British Virgin Islands",
-		"BN": "Brunei",
-		"BG": "Bulgaria",
-		"BF": "Burkina Faso",
-		"BI": "Burundi",
-		"KH": "Cambodia",
-		"CM": "Cameroon",
-		"CA": "Canada",
-		"IC": "Canary Islands",
-		"CV": "Cape Verde",
-		"BQ": "Caribbean Netherlands",
-		"KY": "Cayman Islands",
-		"CF": "Central African Republic",
-		"EA": "Ceuta & Melilla",
-		"TD": "Chad",
-		"CL": "Chile",
-		"CN": "China",
-		"CX": "Christmas Island",
-		"CP": "Clipperton Island",
-		"CC": "Cocos (Keeling) Islands",
-		"CO": "Colombia",
-		"KM": "Comoros",
-		"CG": "Congo - Brazzaville",
-		"CD": "Congo - Kinshasa",
-		"CK": "Cook Islands",
-		"CR": "Costa Rica",
-		"CI": "Côte D’Ivoire",
-		"HR": "Croatia",
-		"CU": "Cuba",
-		"CW": "Curaçao",
-		"CY": "Cyprus",
-		"CZ": "Czechia",
-		"DK": "Denmark",
-		"DG": "Diego Garcia",
-		"DJ": "Djibouti",
-		"DM": "Dominica",
-		"DO": "Dominican Republic",
-		"EC": "Ecuador",
-		"EG": "Egypt",
-		"SV": "El Salvador",
-		"GQ": "Equatorial Guinea",
-		"ER": "Eritrea",
-		"EE": "Estonia",
-		"ET": "Ethiopia",
-		"EU": "European Union",
-		"FK": "Falkland Islands",
-		"FO": "Faroe Islands",
-		"FJ": "Fiji",
-		"FI": "Finland",
-		"FR": "France",
-		"GF": "French Guiana",
-		"PF": "French Polynesia",
-		"TF": "French Southern Territories",
-		"GA": "Gabon",
-		"GM": "Gambia",
-		"GE": "Georgia",
-		"DE": "Germany",
-		"GH": "Ghana",
-		"GI": "Gibraltar",
-		"GR": "Greece",
-		"GL": "Greenland",
-		"GD": "Grenada",
-		"GP": "Guadeloupe",
-		"GU": "Guam",
-		"GT": "Guatemala",
-		"GG": "Guernsey",
-		"GW": "Guinea-Bissau",
-		"GN": "Guinea",
-		"GY": "Guyana",
-		"HT": "Haiti",
-		"HM": "Heard & McDonald Islands",
-		"HN": "Honduras",
-		"HK": "Hong Kong SAR China",
-		"HU": "Hungary",
-		"IS": "Iceland",
-		"IN": "India",
-		"ID": "Indonesia",
-		"IR": "Iran",
-		"IQ": "Iraq",
-		"IE": "Ireland",
-		"IM": "Isle of Man",
-		"IL": "Israel",
-		"IT": "Italy",
-		"JM": "Jamaica",
-		"JP": "Japan",
-		"JE": "Jersey",
-		"JO": "Jordan",
-		"KZ": "Kazakhstan",
-		"KE": "Kenya",
-		"KI": "Kiribati",
-		"XK": "Kosovo",
-		"KW": "Kuwait",
-		"KG": "Kyrgyzstan",
-		"LA": "Laos",
-		"LV": "Latvia",
-		"LB": "Lebanon",
-		"LS": "Lesotho",
-		"LR": "Liberia",
-		"LY": "Libya",
-		"LI": "Liechtenstein",
-		"LT": "Lithuania",
-		"LU": "Luxembourg",
-		"MO": "Macau SAR China",
-		"MK": "Macedonia",
-		"MG": "Madagascar",
-		"MW": "Malawi",
-		"MY": "Malaysia",
-		"MV": "Maldives",
-		"ML": "Mali",
-		"MT": "Malta",
-		"MH": "Marshall Islands",
-		"MQ": "Martinique",
-		"MR": "Mauritania",
-		"MU": "Mauritius",
-		"YT": "Mayotte",
-		"MX": "Mexico",
-		"FM": "Micronesia",
-		"MD": "Moldova",
-		"MC": "Monaco",
-		"MN": "Mongolia",
-		"ME": "Montenegro",
-		"MS": "Montserrat",
-		"MA": "Morocco",
-		"MZ": "Mozambique",
-		"MM": "Myanmar",
-		"NA": "Namibia",
-		"NR": "Nauru",
-		"NP": "Nepal",
-		"NL": "Netherlands",
-		"NC": "New Caledonia",
-		"NZ": "New Zealand",
-		"NI": "Nicaragua",
-		"NE": "Niger",
-		"NG": "Nigeria",
-		"NU": "Niue",
-		"NF": "Norfolk Island",
-		"KP": "North Korea",
-		"MP": "Northern Mariana Islands",
-		"NO": "Norway",
-		"OM": "Oman",
-		"PK": "Pakistan",
-		"PW": "Palau",
-		"PS": "Palestinian Territories",
-		"PA": "Panama",
-		"PG": "Papua New Guinea",
-		"PY": "Paraguay",
-		"PE": "Peru",
-		"PH": "Philippines",
-		"PN": "Pitcairn Islands",
-		"PL": "Poland",
-		"PT": "Portugal",
-		"PR": "Puerto Rico",
-		"QA": "Qatar",
-		"RE": "Réunion",
-		"RO": "Romania",
-		"RU": "Russia",
-		"RW": "Rwanda",
-		"WS": "Samoa",
-		"SM": "San Marino",
-		"ST": "São Tomé & Príncipe",
-		"SA": "Saudi Arabia",
-		"SN": "Senegal",
-		"RS": "Serbia",
-		"SC": "Seychelles",
-		"SL": "Sierra Leone",
-		"SG": "Singapore",
-		"SX": "Sint Maarten",
-		"SK": "Slovakia",
-		"SI": "Slovenia",
-		"SB": "Solomon Islands",
-		"SO": "Somalia",
-		"ZA": "South Africa",
-		"GS": "South Georgia & South Sandwich Islands",
-		"KR": "South Korea",
-		"SS": "South Sudan",
-		"ES": "Spain",
-		"LK": "Sri Lanka",
-		"BL": "St. Barthélemy",
-		"SH": "St. Helena",
-		"KN": "St. Kitts & Nevis",
-		"LC": "St. Lucia",
-		"MF": "St. Martin",
-		"PM": "St. Pierre & Miquelon",
-		"VC": "St. Vincent & Grenadines",
-		"SD": "Sudan",
-		"SR": "Suriname",
-		"SJ": "Svalbard & Jan Mayen",
-		"SZ": "Swaziland",
-		"SE": "Sweden",
-		"CH": "Switzerland",
-		"SY": "Syria",
-		"TW": "Taiwan",
-		"TJ": "Tajikistan",
-		"TZ": "Tanzania",
-		"TH": "Thailand",
-		"TL": "Timor-Leste",
-		"TG": "Togo",
-		"TK": "Tokelau",
-		"TO": "Tonga",
-		"TT": "Trinidad & Tobago",
-		"TN": "Tunisia",
-		"TR": "Turkey",
-		"TM": "Turkmenistan",
-		"TC": "Turks & Caicos Islands",
-		"TV": "Tuvalu",
-		"UG": "Uganda",
-		"UA": "Ukraine",
-		"AE": "United Arab Emirates",
-		"GB": "United Kingdom",
-		"US": "United States",
-		"UY": "Uruguay",
-		"UM": "U.S. Outlying Islands",
-		"VI": "U.S. Virgin Islands",
-		"UZ": "Uzbekistan",
-		"VU": "Vanuatu",
-		"VA": "Vatican City",
-		"VE": "Venezuela",
-		"VN": "Vietnam",
-		"WF": "Wallis & Futuna",
-		"EH": "Western Sahara",
-		"YE": "Yemen",
-		"ZM": "Zambia",
-		"ZW": "Zimbabwe",
-	}
-
-	// https://emojipedia.org/flags/
-	flag = map[string]string{
-		"Afghanistan":          "🇦🇫 ",
-		"Åland Islands":        "🇦🇽 ",
-		"Albania":              "🇦🇱 ",
-		"Algeria":              "🇩🇿 ",
-		"American Samoa":       "🇦🇸 ",
-		"Andorra":              "🇦🇩 ",
-		"Angola":               "🇦🇴 ",
-		"Anguilla":             "🇦🇮 ",
-		"Antarctica":           "🇦🇶 ",
-		"Antigua & Barbuda":    "🇦🇬 ",
-		"Argentina":            "🇦🇷 ",
-		"Armenia":              "🇦🇲 ",
-		"Aruba":                "🇦🇼 ",
-		"Ascension Island":     "🇦🇨 ",
-		"Australia":            "🇦🇺 ",
-		"Austria":              "🇦🇹 ",
-		"Azerbaijan":           "🇦🇿 ",
-		"Bahamas":              "🇧🇸 ",
-		"Bahrain":              "🇧🇭 ",
-		"Bangladesh":           "🇧🇩 ",
-		"Barbados":             "🇧🇧 ",
-		"Belarus":              "🇧🇾 ",
-		"Belgium":              "🇧🇪 ",
-		"Belize":               "🇧🇿 ",
-		"Benin":                "🇧🇯 ",
-		"Bermuda":              "🇧🇲 ",
-		"Bhutan":               "🇧🇹 ",
-		"Bolivia":              "🇧🇴 ",
-		"Bosnia & Herzegovina": "🇧🇦 ",
-		"Botswana":             "🇧🇼 ",
-		"Bouvet Island":        "🇧🇻 ",
-		"Brazil":               "🇧🇷 ",
-		"British Indian Ocean Territory": "🇮🇴 ",
-		"British Virgin Islands":         "🇻🇬 ",
-		"Brunei":                         "🇧🇳 ",
-		"Bulgaria":                       "🇧🇬 ",
-		"Burkina Faso":                   "🇧🇫 ",
-		"Burundi":                        "🇧🇮 ",
-		"Cambodia":                       "🇰🇭 ",
-		"Cameroon":                       "🇨🇲 ",
-		"Canada":                         "🇨🇦 ",
-		"Canary Islands":                 "🇮🇨 ",
-		"Cape Verde":                     "🇨🇻 ",
-		"Caribbean Netherlands":          "🇧🇶 ",
-		"Cayman Islands":                 "🇰🇾 ",
-		"Central African Republic":       "🇨🇫 ",
-		"Ceuta & Melilla":                "🇪🇦 ",
-		"Chad":                           "🇹🇩 ",
-		"Chile":                          "🇨🇱 ",
-		"China":                          "🇨🇳 ",
-		"Christmas Island":               "🇨🇽 ",
-		"Clipperton Island":              "🇨🇵 ",
-		"Cocos (Keeling) Islands":        "🇨🇨 ",
-		"Colombia":                       "🇨🇴 ",
-		"Comoros":                        "🇰🇲 ",
-		"Congo - Brazzaville":            "🇨🇬 ",
-		"Congo - Kinshasa":               "🇨🇩 ",
-		"Cook Islands":                   "🇨🇰 ",
-		"Costa Rica":                     "🇨🇷 ",
-		"Côte D’Ivoire":                  "🇨🇮 ",
-		"Croatia":                        "🇭🇷 ",
-		"Cuba":                           "🇨🇺 ",
-		"Curaçao":                        "🇨🇼 ",
-		"Cyprus":                         "🇨🇾 ",
-		"Czechia":                        "🇨🇿 ",
-		"Denmark":                        "🇩🇰 ",
-		"Diego Garcia":                   "🇩🇬 ",
-		"Djibouti":                       "🇩🇯 ",
-		"Dominica":                       "🇩🇲 ",
-		"Dominican Republic":             "🇩🇴 ",
-		"Ecuador":                        "🇪🇨 ",
-		"Egypt":                          "🇪🇬 ",
-		"El Salvador":                    "🇸🇻 ",
-		"England":                        "🏴󠁧󠁢󠁥󠁮󠁧󠁿",
-		"Equatorial Guinea":              "🇬🇶 ",
-		"Eritrea":                        "🇪🇷 ",
-		"Estonia":                        "🇪🇪 ",
-		"Ethiopia":                       "🇪🇹 ",
-		"European Union":                 "🇪🇺 ",
-		"Falkland Islands":               "🇫🇰 ",
-		"Faroe Islands":                  "🇫🇴 ",
-		"Fiji":                           "🇫🇯 ",
-		"Finland":                        "🇫🇮 ",
-		"France":                         "🇫🇷 ",
-		"French Guiana":                  "🇬🇫 ",
-		"French Polynesia":               "🇵🇫 ",
-		"French Southern Territories":    "🇹🇫 ",
-		"Gabon":                                  "🇬🇦 ",
-		"Gambia":                                 "🇬🇲 ",
-		"Georgia":                                "🇬🇪 ",
-		"Germany":                                "🇩🇪 ",
-		"Ghana":                                  "🇬🇭 ",
-		"Gibraltar":                              "🇬🇮 ",
-		"Greece":                                 "🇬🇷 ",
-		"Greenland":                              "🇬🇱 ",
-		"Grenada":                                "🇬🇩 ",
-		"Guadeloupe":                             "🇬🇵 ",
-		"Guam":                                   "🇬🇺 ",
-		"Guatemala":                              "🇬🇹 ",
-		"Guernsey":                               "🇬🇬 ",
-		"Guinea-Bissau":                          "🇬🇼 ",
-		"Guinea":                                 "🇬🇳 ",
-		"Guyana":                                 "🇬🇾 ",
-		"Haiti":                                  "🇭🇹 ",
-		"Heard & McDonald Islands":               "🇭🇲 ",
-		"Honduras":                               "🇭🇳 ",
-		"Hong Kong SAR China":                    "🇭🇰 ",
-		"Hungary":                                "🇭🇺 ",
-		"Iceland":                                "🇮🇸 ",
-		"India":                                  "🇮🇳 ",
-		"Indonesia":                              "🇮🇩 ",
-		"Iran":                                   "🇮🇷 ",
-		"Iraq":                                   "🇮🇶 ",
-		"Ireland":                                "🇮🇪 ",
-		"Isle of Man":                            "🇮🇲 ",
-		"Israel":                                 "🇮🇱 ",
-		"Italy":                                  "🇮🇹 ",
-		"Jamaica":                                "🇯🇲 ",
-		"Japan":                                  "🇯🇵 ",
-		"Jersey":                                 "🇯🇪 ",
-		"Jordan":                                 "🇯🇴 ",
-		"Kazakhstan":                             "🇰🇿 ",
-		"Kenya":                                  "🇰🇪 ",
-		"Kiribati":                               "🇰🇮 ",
-		"Kosovo":                                 "🇽🇰 ",
-		"Kuwait":                                 "🇰🇼 ",
-		"Kyrgyzstan":                             "🇰🇬 ",
-		"Laos":                                   "🇱🇦 ",
-		"Latvia":                                 "🇱🇻 ",
-		"Lebanon":                                "🇱🇧 ",
-		"Lesotho":                                "🇱🇸 ",
-		"Liberia":                                "🇱🇷 ",
-		"Libya":                                  "🇱🇾 ",
-		"Liechtenstein":                          "🇱🇮 ",
-		"Lithuania":                              "🇱🇹 ",
-		"Luxembourg":                             "🇱🇺 ",
-		"Macau SAR China":                        "🇲🇴 ",
-		"Macedonia":                              "🇲🇰 ",
-		"Madagascar":                             "🇲🇬 ",
-		"Malawi":                                 "🇲🇼 ",
-		"Malaysia":                               "🇲🇾 ",
-		"Maldives":                               "🇲🇻 ",
-		"Mali":                                   "🇲🇱 ",
-		"Malta":                                  "🇲🇹 ",
-		"Marshall Islands":                       "🇲🇭 ",
-		"Martinique":                             "🇲🇶 ",
-		"Mauritania":                             "🇲🇷 ",
-		"Mauritius":                              "🇲🇺 ",
-		"Mayotte":                                "🇾🇹 ",
-		"Mexico":                                 "🇲🇽 ",
-		"Micronesia":                             "🇫🇲 ",
-		"Moldova":                                "🇲🇩 ",
-		"Monaco":                                 "🇲🇨 ",
-		"Mongolia":                               "🇲🇳 ",
-		"Montenegro":                             "🇲🇪 ",
-		"Montserrat":                             "🇲🇸 ",
-		"Morocco":                                "🇲🇦 ",
-		"Mozambique":                             "🇲🇿 ",
-		"Myanmar (Burma)":                        "🇲🇲 ",
-		"Namibia":                                "🇳🇦 ",
-		"Nauru":                                  "🇳🇷 ",
-		"Nepal":                                  "🇳🇵 ",
-		"Netherlands":                            "🇳🇱 ",
-		"New Caledonia":                          "🇳🇨 ",
-		"New Zealand":                            "🇳🇿 ",
-		"Nicaragua":                              "🇳🇮 ",
-		"Niger":                                  "🇳🇪 ",
-		"Nigeria":                                "🇳🇬 ",
-		"Niue":                                   "🇳🇺 ",
-		"Norfolk Island":                         "🇳🇫 ",
-		"North Korea":                            "🇰🇵 ",
-		"Northern Mariana Islands":               "🇲🇵 ",
-		"Norway":                                 "🇳🇴 ",
-		"Oman":                                   "🇴🇲 ",
-		"Pakistan":                               "🇵🇰 ",
-		"Palau":                                  "🇵🇼 ",
-		"Palestinian Territories":                "🇵🇸 ",
-		"Panama":                                 "🇵🇦 ",
-		"Papua New Guinea":                       "🇵🇬 ",
-		"Paraguay":                               "🇵🇾 ",
-		"Peru":                                   "🇵🇪 ",
-		"Philippines":                            "🇵🇭 ",
-		"Pitcairn Islands":                       "🇵🇳 ",
-		"Poland":                                 "🇵🇱 ",
-		"Portugal":                               "🇵🇹 ",
-		"Puerto Rico":                            "🇵🇷 ",
-		"Qatar":                                  "🇶🇦 ",
-		"Réunion":                                "🇷🇪 ",
-		"Romania":                                "🇷🇴 ",
-		"Russia":                                 "🇷🇺 ",
-		"Rwanda":                                 "🇷🇼 ",
-		"Samoa":                                  "🇼🇸 ",
-		"San Marino":                             "🇸🇲 ",
-		"São Tomé & Príncipe":                    "🇸🇹 ",
-		"Saudi Arabia":                           "🇸🇦 ",
-		"Scotland":                               "🏴󠁧󠁢󠁳󠁣󠁴󠁿",
-		"Senegal":                                "🇸🇳 ",
-		"Serbia":                                 "🇷🇸 ",
-		"Seychelles":                             "🇸🇨 ",
-		"Sierra Leone":                           "🇸🇱 ",
-		"Singapore":                              "🇸🇬 ",
-		"Sint Maarten":                           "🇸🇽 ",
-		"Slovakia":                               "🇸🇰 ",
-		"Slovenia":                               "🇸🇮 ",
-		"Solomon Islands":                        "🇸🇧 ",
-		"Somalia":                                "🇸🇴 ",
-		"South Africa":                           "🇿🇦 ",
-		"South Georgia & South Sandwich Islands": "🇬🇸 ",
-		"South Korea":                            "🇰🇷 ",
-		"South Sudan":                            "🇸🇸 ",
-		"Spain":                                  "🇪🇸 ",
-		"Sri Lanka":                              "🇱🇰 ",
-		"St. Barthélemy":                         "🇧🇱 ",
-		"St. Helena":                             "🇸🇭 ",
-		"St. Kitts & Nevis":                      "🇰🇳 ",
-		"St. Lucia":                              "🇱🇨 ",
-		"St. Martin":                             "🇲🇫 ",
-		"St. Pierre & Miquelon":                  "🇵🇲 ",
-		"St. Vincent & Grenadines":               "🇻🇨 ",
-		"Sudan":                                  "🇸🇩 ",
-		"Suriname":                               "🇸🇷 ",
-		"Svalbard & Jan Mayen":                   "🇸🇯 ",
-		"Swaziland":                              "🇸🇿 ",
-		"Sweden":                                 "🇸🇪 ",
-		"Switzerland":                            "🇨🇭 ",
-		"Syria":                                  "🇸🇾 ",
-		"Taiwan":                                 "🇹🇼 ",
-		"Tajikistan":                             "🇹🇯 ",
-		"Tanzania":                               "🇹🇿 ",
-		"Thailand":                               "🇹🇭 ",
-		"Timor-Leste":                            "🇹🇱 ",
-		"Togo":                                   "🇹🇬 ",
-		"Tokelau":                                "🇹🇰 ",
-		"Tonga":                                  "🇹🇴 ",
-		"Trinidad & Tobago":                      "🇹🇹 ",
-		"Tristan Da Cunha":                       "🇹🇦 ",
-		"Tunisia":                                "🇹🇳 ",
-		"Turkey":                                 "🇹🇷 ",
-		"Turkmenistan":                           "🇹🇲 ",
-		"Turks & Caicos Islands":                 "🇹🇨 ",
-		"Tuvalu":                                 "🇹🇻 ",
-		"Uganda":                                 "🇺🇬 ",
-		"Ukraine":                                "🇺🇦 ",
-		"United Arab Emirates":                   "🇦🇪 ",
-		"United Kingdom":                         "🇬🇧 ",
-		"United States":                          "🇺🇸 ",
-		"Uruguay":                                "🇺🇾 ",
-		"U.S. Outlying Islands":                  "🇺🇲 ",
-		"U.S. Virgin Islands":                    "🇻🇮 ",
-		"UTC":             "☁️ ",
-		"Uzbekistan":      "🇺🇿 ",
-		"Vanuatu":         "🇻🇺 ",
-		"Vatican City":    "🇻🇦 ",
-		"Venezuela":       "🇻🇪 ",
-		"Vietnam":         "🇻🇳 ",
-		"Wales":           "🏴󠁧󠁢󠁷󠁬󠁳󠁿",
-		"Wallis & Futuna": "🇼🇫 ",
-		"Western Sahara":  "🇪🇭 ",
-		"Yemen":           "🇾🇪 ",
-		"Zambia":          "🇿🇲 ",
-		"Zimbabwe":        "🇿🇼 ",
-		"Chequered":       "🏁",
-		"Triangular":      "🚩",
-		"Crossed":         "🎌",
-		"Black":           "🏴",
-		"White":           "🏳 ",
-		"Rainbow":         "🏳️‍🌈 ",
-	}
+	bold = color.New(color.Bold).SprintFunc()
 )
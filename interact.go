@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/tanakapayam/mtzdate/timetable"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// keys, as sent by xterm and its descendants in raw mode
+const (
+	keyCtrlC      = "\x03"
+	keyTab        = "\t"
+	keyEnter      = "\r"
+	keyEscape     = "\x1b"
+	keyBackspace  = "\x7f"
+	keyCtrlH      = "\x08"
+	keyUp         = "\x1b[A"
+	keyDown       = "\x1b[B"
+	keyRight      = "\x1b[C"
+	keyLeft       = "\x1b[D"
+	keyShiftRight = "\x1b[1;2C"
+	keyShiftLeft  = "\x1b[1;2D"
+	keyShiftTab   = "\x1b[Z"
+)
+
+// interact is --loop on a terminal: the displayed instant can be scrubbed
+// with the arrow keys, and zones added on the fly. The highlighted column
+// applies to cfg.Render only.
+func interact(cfg *timetable.Config, render func(time.Time, io.Writer) error, now time.Time) {
+	skew := time.Until(now)
+
+	fd := int(os.Stdin.Fd())
+	state, err := terminal.MakeRaw(fd)
+	die(err)
+
+	restore := func() {
+		fmt.Print(clearScreen + mainScreen + showCursor)
+		terminal.Restore(fd, state) // nolint: errcheck
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	keys := make(chan string)
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				close(keys)
+				return
+			}
+			keys <- string(buf[:n])
+		}
+	}()
+
+	fmt.Print(hideCursor + altScreen)
+
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+
+	// the column to highlight, by index into cfg.Format
+	selected := strings.IndexRune(cfg.Format, 'd')
+	if selected < 0 {
+		selected = 0
+	}
+
+	var (
+		searching bool
+		query     string
+		status    string
+	)
+
+	for {
+		if len(cfg.Format) > 0 {
+			cfg.Highlight = []rune(cfg.Format)[selected%len([]rune(cfg.Format))]
+		}
+
+		// draw the whole frame at once to avoid flicker
+		var frame bytes.Buffer
+		frame.WriteString(home)
+
+		err := render(time.Now().Add(skew), &frame)
+
+		fmt.Fprintln(&frame)
+		switch {
+		case searching:
+			fmt.Fprintf(&frame, "/%s%s", query, showCursor)
+		case status != "":
+			fmt.Fprintf(&frame, "%s%s", status, hideCursor)
+		default:
+			fmt.Fprintf(&frame, "%s  ←/→ ±1h  ⇧←/⇧→ ±15m  ↑/↓ ±1d  tab column  n now  / add zone  q quit%s",
+				bold(describeSkew(skew)),
+				hideCursor,
+			)
+		}
+		frame.WriteString(clearToEnd)
+
+		if err == nil {
+			// raw mode does not turn \n into \r\n
+			_, err = os.Stdout.Write(bytes.Replace(frame.Bytes(), []byte("\n"), []byte("\r\n"), -1))
+		}
+
+		if err != nil {
+			restore()
+			die(err)
+		}
+
+		select {
+		case <-c:
+			restore()
+			os.Exit(0)
+
+		case <-tick.C:
+
+		case key, ok := <-keys:
+			status = ""
+
+			switch {
+			case !ok, !searching && (key == "q" || key == keyCtrlC):
+				restore()
+				os.Exit(0)
+
+			case searching:
+				switch key {
+				case keyEnter:
+					searching = false
+					status = addZone(cfg, query)
+				case keyEscape, keyCtrlC:
+					searching = false
+				case keyBackspace, keyCtrlH:
+					if r := []rune(query); len(r) > 0 {
+						query = string(r[:len(r)-1])
+					}
+				default:
+					if !strings.HasPrefix(key, keyEscape) {
+						query += key
+					}
+				}
+
+			case key == "/":
+				searching, query = true, ""
+
+			case key == "n":
+				skew = 0
+
+			case key == keyRight:
+				skew += time.Hour
+			case key == keyLeft:
+				skew -= time.Hour
+			case key == keyShiftRight:
+				skew += 15 * time.Minute
+			case key == keyShiftLeft:
+				skew -= 15 * time.Minute
+			case key == keyUp:
+				skew += 24 * time.Hour
+			case key == keyDown:
+				skew -= 24 * time.Hour
+
+			case key == keyTab:
+				selected++
+			case key == keyShiftTab:
+				selected += len([]rune(cfg.Format)) - 1
+			}
+		}
+	}
+}
+
+// addZone resolves query as FindZone does, e.g. "new york" ->
+// America/New_York, and appends it to cfg.Zones. It returns a message for the
+// status line.
+func addZone(cfg *timetable.Config, query string) string {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return ""
+	}
+
+	z, ok := cfg.FindZone(query)
+	if !ok {
+		return fmt.Sprintf("no time zone matches %q", query)
+	}
+
+	cfg.Zones = append(cfg.Zones, z)
+	if len(z.Rivals) > 0 {
+		return fmt.Sprintf("added %s (%s), not %s (%s)", z.Name, z.Country, z.Rivals[0].Zone, z.Rivals[0].Country)
+	}
+	return "added " + z.Name
+}
+
+// describeSkew says how far the displayed instant is from now.
+func describeSkew(skew time.Duration) string {
+	skew = skew.Round(time.Minute)
+	if skew == 0 {
+		return "now"
+	}
+	if skew > 0 {
+		return "now +" + shortDuration(skew)
+	}
+	return "now -" + shortDuration(-skew)
+}
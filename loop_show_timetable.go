@@ -1,43 +1,82 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
-	"github.com/codeskyblue/go-sh"
+	"github.com/mattn/go-isatty"
 )
 
-func loopShowTimeTable() {
+// ANSI escape sequences, as printed by tput civis, smcup, home, ed, clear,
+// rmcup and cnorm on xterm and its descendants.
+const (
+	hideCursor  = "\x1b[?25l"
+	altScreen   = "\x1b[?1049h"
+	home        = "\x1b[H"
+	clearToEnd  = "\x1b[J"
+	clearScreen = "\x1b[2J"
+	mainScreen  = "\x1b[?1049l"
+	showCursor  = "\x1b[?25h"
+)
+
+func loopShowTimeTable(render func(time.Time, io.Writer) error, now time.Time) {
+	// keep ticking from --at, if given
+	skew := time.Until(now)
+
+	// redraw in place on terminals; append otherwise
+	tty := isatty.IsTerminal(os.Stdout.Fd()) && os.Getenv("TERM") != "dumb"
+
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 
-	err := sh.Command("tput", "civis").Run()
-	die(err)
+	restore := func() {
+		if tty {
+			fmt.Print(clearScreen + mainScreen + showCursor)
+		}
+	}
 
-	err = sh.Command("tput", "smcup").Run()
-	die(err)
+	if tty {
+		fmt.Print(hideCursor + altScreen)
+	}
+
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
 
-	go func() {
-		<-c
+	for {
+		// draw the whole frame at once to avoid flicker
+		var frame bytes.Buffer
 
-		err := sh.Command("tput", "clear").Run()
-		die(err)
+		if tty {
+			frame.WriteString(home)
+		}
 
-		err = sh.Command("tput", "rmcup").Run()
-		die(err)
+		err := render(time.Now().Add(skew), &frame)
 
-		err = sh.Command("tput", "cnorm").Run()
-		die(err)
+		if tty {
+			frame.WriteString(clearToEnd)
+		} else {
+			frame.WriteString("\n")
+		}
 
-		os.Exit(0)
-	}()
+		if err == nil {
+			_, err = os.Stdout.Write(frame.Bytes())
+		}
 
-	for {
-		err := sh.Command("tput", "home").Run()
-		die(err)
+		if err != nil {
+			restore()
+			die(err)
+		}
 
-		showTimeTable()
-		time.Sleep(time.Second)
+		select {
+		case <-c:
+			restore()
+			os.Exit(0)
+		case <-tick.C:
+		}
 	}
 }
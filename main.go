@@ -1,17 +1,124 @@
 package main
 
 import (
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+	// fall back to Go's copy of tzdata where the system has none
+	_ "time/tzdata"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/tanakapayam/mtzdate/timetable"
 )
 
 func main() {
-	setTimezones()
-	updateFlags()
-	setWorkhours()
+	// --color beats NO_COLOR, which beats the terminal check of color.NoColor
+	switch when, _ := args["--color"].(string); when {
+	case "", "auto":
+		if v, ok := os.LookupEnv("NO_COLOR"); ok && v != "" {
+			color.NoColor = true
+		}
+	case "always":
+		color.NoColor = false
+	case "never":
+		color.NoColor = true
+	default:
+		die(fmt.Errorf("--color %q: want auto, always or never", when))
+	}
+
+	// flags > env > file > built-in defaults
+	cfg := timetable.New()
+
+	var errs []error
+	path := configPath()
+	if path != "" {
+		errs = append(errs, cfg.LoadFile(path, os.LookupEnv))
+	} else {
+		errs = append(errs, cfg.LoadEnv(os.LookupEnv))
+	}
+
+	if args["doctor"].(bool) {
+		showDoctor(cfg, path, errs)
+		return
+	}
+
+	report(errs, args["--strict"].(bool))
+	warnAmbiguous(cfg.Zones)
+
+	now := time.Now()
+	if at, ok := args["--at"].(string); ok {
+		var err error
+		now, err = cfg.ParseTime(at, now)
+		die(err)
+	}
+
+	if ref, ok := args["--ref"].(string); ok {
+		cfg.Ref = findZone(cfg, "--ref", ref).Location
+	}
+
+	if tmpl, ok := args["--template"].(string); ok {
+		cfg.Template = tmpl
+	}
+
+	if layout, ok := args["--layout"].(string); ok {
+		var err error
+		cfg.Layout, err = timetable.ParseLayout(layout)
+		if err != nil {
+			die(fmt.Errorf("--layout %q: %v", layout, err))
+		}
+	}
+
+	if dst, ok := args["--dst"].(string); ok {
+		days, err := strconv.Atoi(dst)
+		die(err)
+		cfg.DSTDays = days
+		if !strings.ContainsRune(cfg.Format, 't') {
+			cfg.Format += "t"
+		}
+	}
+
+	var render func(time.Time, io.Writer) error
+
+	switch output := args["--output"].(string); output {
+	case "text":
+		render = cfg.Render
+		if cfg.Template != "" {
+			render = cfg.RenderTemplate
+		}
+		if args["--ruler"].(bool) {
+			render = cfg.RenderRuler
+		}
+	case "json":
+		render = cfg.RenderJSON
+	case "csv", "tsv":
+		comma := map[string]rune{"csv": ',', "tsv": '\t'}[output]
+		render = func(now time.Time, w io.Writer) error {
+			return cfg.RenderCSV(now, w, comma)
+		}
+	default:
+		die(fmt.Errorf("unknown output format %q: want text, json, csv or tsv", output))
+	}
 
-	if loop, ok := os.LookupEnv("MTZDATE_LOOP"); ok && loop != "" && loop != "0" {
-		loopShowTimeTable()
+	if args["meet"].(bool) {
+		showMeetingSlots(cfg, now)
+	} else if args["zones"].(bool) {
+		showZones(now)
+	} else if args["transitions"].(bool) {
+		showTransitions(cfg, now)
+	} else if args["convert"].(bool) {
+		showConvert(cfg, render, now)
+	} else if args["--loop"].(bool) || cfg.Loop {
+		if args["--output"] == "text" &&
+			isatty.IsTerminal(os.Stdin.Fd()) && isatty.IsTerminal(os.Stdout.Fd()) && os.Getenv("TERM") != "dumb" {
+			interact(cfg, render, now)
+		} else {
+			loopShowTimeTable(render, now)
+		}
 	} else {
-		showTimeTable()
+		die(render(now, os.Stdout))
 	}
 }
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// report warns of the configuration problems that loading worked around, all
+// of them at once, or with --strict exits on them. Any other error is fatal.
+func report(errs []error, strict bool) {
+	var problems timetable.Problems
+
+	for _, err := range errs {
+		if p, ok := err.(timetable.Problems); ok {
+			problems = append(problems, p...)
+		} else {
+			die(err)
+		}
+	}
+
+	for _, p := range problems {
+		if strict {
+			log.Error(p)
+		} else {
+			log.Warn(p)
+		}
+	}
+
+	if strict && len(problems) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// showConvert renders the wall-clock time <time> in the --from zone, then in
+// the --to zones or else cfg.Zones, saying how a time that a change of UTC
+// offset skips or repeats was read.
+func showConvert(cfg *timetable.Config, render func(time.Time, io.Writer) error, now time.Time) {
+	s := args["<time>"].(string)
+
+	var zones []timetable.Zone
+	loc := time.Local
+	if from, ok := args["--from"].(string); ok {
+		z := findZone(cfg, "--from", from)
+		zones = append(zones, z)
+		loc = z.Location
+	}
+
+	t, note, err := cfg.ResolveTime(s, now, loc)
+	die(err)
+	if note != "" {
+		log.Warn(note)
+	}
+
+	targets := cfg.Zones
+	if to, ok := args["--to"].(string); ok {
+		targets = nil
+		for _, name := range strings.Split(to, ",") {
+			targets = append(targets, findZone(cfg, "--to", strings.TrimSpace(name)))
+		}
+	}
+
+	// the source zone leads, once
+	for _, z := range targets {
+		if len(zones) == 0 || z.Label != zones[0].Label || z.Name != zones[0].Name {
+			zones = append(zones, z)
+		}
+	}
+
+	cfg.Zones = zones
+	die(render(t, os.Stdout))
+}
+
+// findZone resolves name as FindZone does, warning if it is ambiguous and
+// dying if it is unknown.
+func findZone(cfg *timetable.Config, option, name string) timetable.Zone {
+	z, ok := cfg.FindZone(name)
+	if !ok {
+		die(fmt.Errorf("%s: unknown time zone %q", option, name))
+	}
+	warnAmbiguous([]timetable.Zone{z})
+
+	return z
+}
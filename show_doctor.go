@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// showDoctor prints what mtzdate made of its settings and surroundings: the
+// effective configuration, the tzdata in use, what the terminal can show,
+// and the problems that loading worked around.
+func showDoctor(cfg *timetable.Config, path string, errs []error) {
+	row := func(key, format string, a ...interface{}) {
+		fmt.Printf("  %-10s %s\n", key, fmt.Sprintf(format, a...))
+	}
+	or := func(s, none string) string {
+		if s == "" {
+			return none
+		}
+		return s
+	}
+
+	fmt.Println("Configuration")
+	row("file", "%s", or(path, "(none)"))
+	var env []string
+	for _, kv := range os.Environ() {
+		if strings.HasPrefix(kv, "MTZDATE_") {
+			env = append(env, kv)
+		}
+	}
+	sort.Strings(env)
+	for _, kv := range env {
+		row("env", "%s", kv)
+	}
+
+	for i, z := range cfg.Zones {
+		key := ""
+		if i == 0 {
+			key = "zones"
+		}
+
+		s := fmt.Sprintf("%-16s %-32s %-2s", z.Label, z.Name, z.Country)
+		if z.Workdays != nil || z.GreenHours != nil {
+			// the schedule as ParseSchedule reads it, e.g. @Sun+Mon+Tue+Wed+Thu/9-18
+			s += fmt.Sprintf(" @%s/%s", strings.Replace(weekdays(z.Workdays), " ", "+", -1), timetable.FormatHours(z.GreenHours))
+		}
+		if z.Locale != nil {
+			s += " @" + z.Locale.Code
+		}
+		row(key, "%s", strings.TrimRight(s, " "))
+	}
+
+	row("workdays", "%s", or(weekdays(cfg.Workdays), "(none)"))
+	row("green", "%s", or(timetable.FormatHours(cfg.GreenHours), "(none)"))
+	row("yellow", "%s", or(timetable.FormatHours(cfg.YellowHours), "(none)"))
+	row("faint", "%s", or(timetable.FormatHours(cfg.FaintHours), "(none)"))
+	row("format", "%s", cfg.Format)
+	row("layout", "%s", cfg.Layout)
+	if cfg.Locale != nil {
+		row("locale", "%s", cfg.Locale.Code)
+	} else {
+		row("locale", "%s", "(none)")
+	}
+	row("styles", "green %q, yellow %q, faint %q, highlight %q",
+		cfg.Theme.Green, cfg.Theme.Yellow, cfg.Theme.Faint, cfg.Theme.Highlight)
+	row("dst days", "%d", cfg.DSTDays)
+
+	ref := time.Local
+	if cfg.Ref != nil {
+		ref = cfg.Ref
+	}
+	row("ref", "%s (%s)", ref, time.Now().In(ref).Format("MST -07:00"))
+	row("template", "%s", or(cfg.Template, "(none)"))
+
+	var countries []string
+	for cc := range cfg.Holidays {
+		countries = append(countries, cc)
+	}
+	sort.Strings(countries)
+	row("holidays", "%s", or(strings.Join(countries, " "), "(none)"))
+
+	fmt.Println("\nTime zone database")
+	source, version := timetable.TZDataSource()
+	row("source", "%s", or(source, "time/tzdata, compiled in"))
+	if source == "" {
+		version = "as of " + runtime.Version()
+	}
+	row("version", "%s", or(version, "(unknown)"))
+	row("zones", "%d", len(timetable.ZoneNames()))
+	tz, ok := os.LookupEnv("TZ")
+	if !ok {
+		tz = "(unset, see /etc/localtime)"
+	}
+	row("TZ", "%s", tz)
+	row("local", "%s", time.Now().Format("MST -07:00"))
+
+	fmt.Println("\nTerminal")
+	terminal := isatty.IsTerminal(os.Stdout.Fd())
+	row("stdout", "%s", map[bool]string{true: "terminal", false: "not a terminal"}[terminal])
+	for _, key := range []string{"TERM", "COLORTERM", "NO_COLOR", "LC_ALL", "LC_CTYPE", "LANG"} {
+		if v, ok := os.LookupEnv(key); ok {
+			row(key, "%q", v)
+		}
+	}
+
+	colors := "none"
+	switch term, colorterm := os.Getenv("TERM"), os.Getenv("COLORTERM"); {
+	case color.NoColor:
+	case colorterm == "truecolor" || colorterm == "24bit":
+		colors = "24-bit"
+	case strings.Contains(term, "256color"):
+		colors = "256"
+	default:
+		colors = "16"
+	}
+	row("colors", "%s", colors)
+
+	// the first of LC_ALL, LC_CTYPE and LANG that is set decides the charset
+	locale := os.Getenv("LANG")
+	for _, key := range []string{"LC_CTYPE", "LC_ALL"} {
+		if v := os.Getenv(key); v != "" {
+			locale = v
+		}
+	}
+	utf8 := strings.Contains(strings.ToLower(strings.Replace(locale, "-", "", -1)), "utf8")
+	emoji := utf8 && os.Getenv("TERM") != "linux" && os.Getenv("TERM") != "dumb"
+	row("emoji", "%s", map[bool]string{true: "likely", false: "unlikely"}[emoji])
+	row("sample", "%s %s %s %s %s %s  <- a flag, a cloud and the bands",
+		timetable.Flag("JP"), timetable.Flag(timetable.UTC),
+		cfg.Theme.Green.Sprint("green"), cfg.Theme.Yellow.Sprint("yellow"),
+		cfg.Theme.Faint.Sprint("faint"), cfg.Theme.Highlight.Sprint("highlight"))
+
+	fmt.Println("\nProblems")
+	n := 0
+	for _, err := range errs {
+		problems, ok := err.(timetable.Problems)
+		if !ok && err != nil {
+			fmt.Println("  " + err.Error())
+			n++
+		}
+		for _, p := range problems {
+			fmt.Println("  " + p.Error())
+			n++
+		}
+	}
+	for _, z := range cfg.Zones {
+		if len(z.Rivals) > 0 {
+			fmt.Println("  " + ambiguity(z))
+			n++
+		}
+	}
+	if n == 0 {
+		fmt.Println("  (none)")
+	}
+}
+
+// weekdays lists a set of weekday names in calendar order, e.g. "Mon Tue".
+func weekdays(set map[string]bool) string {
+	var days []string
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if name := d.String()[:3]; set[name] {
+			days = append(days, name)
+		}
+	}
+	return strings.Join(days, " ")
+}
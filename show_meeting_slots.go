@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// showMeetingSlots lists the best meeting windows across cfg.Zones.
+func showMeetingSlots(cfg *timetable.Config, now time.Time) {
+	var err error
+
+	o := timetable.MeetOptions{
+		From: now,
+	}
+
+	if from, ok := args["--from"].(string); ok {
+		o.From, err = cfg.ParseTime(from, now)
+		die(err)
+	}
+
+	days := 7
+	if s, ok := args["--days"].(string); ok {
+		days, err = strconv.Atoi(s)
+		die(err)
+	}
+	o.To = o.From.AddDate(0, 0, days)
+
+	o.Step, err = time.ParseDuration(args["--step"].(string))
+	die(err)
+
+	o.Duration, err = time.ParseDuration(args["--duration"].(string))
+	die(err)
+
+	o.Top, err = strconv.Atoi(args["--top"].(string))
+	die(err)
+
+	required := zoneIndexes(cfg, args["--required"])
+	optional := zoneIndexes(cfg, args["--optional"])
+
+	for _, i := range optional {
+		if contains(required, i) {
+			die(fmt.Errorf("%s is both --required and --optional", cfg.Zones[i].Label))
+		}
+	}
+
+	// unless told otherwise, everyone but UTC is required
+	for i, z := range cfg.Zones {
+		switch {
+		case contains(required, i) || contains(optional, i):
+		case z.Name == timetable.UTC:
+		case required == nil:
+			o.Required = append(o.Required, i)
+		default:
+			o.Optional = append(o.Optional, i)
+		}
+	}
+	o.Required = append(o.Required, required...)
+	o.Optional = append(o.Optional, optional...)
+
+	windows := cfg.Meet(o)
+	if len(windows) == 0 {
+		_, err = fmt.Fprintln(os.Stderr, "no slot fits all required zones")
+		die(err)
+		os.Exit(1)
+	}
+
+	for n, w := range windows {
+		fmt.Printf("%d. score %d, %s starting %s\n",
+			n+1,
+			w.Score,
+			shortDuration(o.Duration),
+			timeRange(w.Start, w.End, time.UTC),
+		)
+
+		width := 0
+		for _, z := range cfg.Zones {
+			if l := len(timeRange(w.Start, w.End, z.Location)); l > width {
+				width = l
+			}
+		}
+
+		for i, z := range cfg.Zones {
+			if !contains(o.Required, i) && !contains(o.Optional, i) {
+				continue
+			}
+
+			note := ""
+			if contains(o.Optional, i) {
+				note = " (optional)"
+			}
+
+			fmt.Printf("   %s %-2s %s%s\n",
+				cfg.Theme.Sprint(w.Bands[i], fmt.Sprintf("%-*s", width, timeRange(w.Start, w.End, z.Location))),
+				z.Flag,
+				z.Label,
+				note,
+			)
+		}
+		fmt.Println()
+	}
+}
+
+// timeRange formats "Tue Oct 20 15:00-16:15 UTC" in loc.
+func timeRange(start, end time.Time, loc *time.Location) string {
+	start, end = start.In(loc), end.In(loc)
+
+	if start.Equal(end) {
+		return start.Format("Mon Jan _2 15:04 MST")
+	}
+	if start.YearDay() != end.YearDay() {
+		return start.Format("Mon Jan _2 15:04") + "-" + end.Format("Mon Jan _2 15:04 MST")
+	}
+	return start.Format("Mon Jan _2 15:04") + "-" + end.Format("15:04 MST")
+}
+
+// shortDuration formats 90m as "1h30m" rather than "1h30m0s".
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// zoneIndexes resolves a comma-separated list of zones against cfg.Zones,
+// adding any that are missing.
+func zoneIndexes(cfg *timetable.Config, list interface{}) []int {
+	var indexes []int
+
+	s, ok := list.(string)
+	if !ok {
+		return nil
+	}
+
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		i := cfg.ZoneIndex(name)
+		if i < 0 {
+			z, ok := cfg.FindZone(name)
+			if !ok {
+				die(fmt.Errorf("unknown time zone %q", name))
+			}
+			cfg.Zones = append(cfg.Zones, z)
+			i = len(cfg.Zones) - 1
+		}
+		if !contains(indexes, i) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+func contains(a []int, v int) bool {
+	for _, i := range a {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
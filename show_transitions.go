@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// showTransitions lists the changes of UTC offset across cfg.Zones within the
+// next --days, earliest first, so that the weeks when only some zones have
+// switched stand out.
+func showTransitions(cfg *timetable.Config, now time.Time) {
+	days := 365
+	if s, ok := args["--days"].(string); ok {
+		var err error
+		days, err = strconv.Atoi(s)
+		die(err)
+	}
+
+	transitions := cfg.Transitions(now, now.AddDate(0, 0, days))
+	if len(transitions) == 0 {
+		_, err := fmt.Fprintf(os.Stderr, "no UTC offset changes within %d days\n", days)
+		die(err)
+		os.Exit(1)
+	}
+
+	width := 0
+	for _, tr := range transitions {
+		if w := timetable.DisplayWidth(tr.Zone.Label); w > width {
+			width = w
+		}
+	}
+
+	for _, tr := range transitions {
+		// the wall clock just before, e.g. 03:00 CEST -> 02:00 CET
+		_, off := tr.At.Zone()
+		old := tr.At.In(time.FixedZone(tr.Before, off-int(tr.Delta/time.Second)))
+
+		flag := tr.Zone.Flag
+		if flag == "" {
+			flag = "  "
+		}
+
+		fmt.Printf("%s → %-11s %-4s %s  %s%*s  in %s\n",
+			old.Format("Mon Jan _2 2006 15:04 MST"),
+			tr.At.Format("15:04 MST"),
+			signedDuration(tr.Delta),
+			flag,
+			tr.Zone.Label,
+			width-timetable.DisplayWidth(tr.Zone.Label),
+			"",
+			timetable.Countdown(tr.At.Sub(now)),
+		)
+	}
+}
+
+// signedDuration formats a change of offset as "+1h" or "-30m".
+func signedDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + shortDuration(-d)
+	}
+	return "+" + shortDuration(d)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// showZones lists the time zones matching the query argument, if any, with
+// their current time and UTC offset.
+func showZones(now time.Time) {
+	query, _ := args["<query>"].(string)
+
+	zones := timetable.SearchZones(query, now)
+	if len(zones) == 0 {
+		_, err := fmt.Fprintf(os.Stderr, "no time zone matches %q\n", query)
+		die(err)
+		os.Exit(1)
+	}
+
+	width := 0
+	for _, z := range zones {
+		if len(z.Name) > width {
+			width = len(z.Name)
+		}
+	}
+
+	for _, z := range zones {
+		t := now.In(z.Location)
+
+		flag := z.Flag
+		if flag == "" {
+			flag = "  "
+		}
+
+		country := ""
+		if z.Country != "" {
+			country = z.Country + " " + timetable.CountryName(z.Country)
+		}
+
+		fmt.Println(strings.TrimRight(fmt.Sprintf("%s %-5s %s %s %-*s %s",
+			t.Format("Mon Jan _2 15:04"),
+			t.Format("MST"),
+			t.Format("-07:00"),
+			flag,
+			width,
+			z.Name,
+			country,
+		), " "))
+	}
+}
@@ -0,0 +1,104 @@
+package timetable
+
+import (
+	"time"
+)
+
+// Band is the work-hour band an instant falls in.
+type Band int
+
+// Bands, in the order Band applies them; a later band wins where ranges
+// overlap.
+const (
+	None Band = iota
+	Green
+	Yellow
+	Faint
+)
+
+func (b Band) String() string {
+	switch b {
+	case Green:
+		return "green"
+	case Yellow:
+		return "yellow"
+	case Faint:
+		return "faint"
+	}
+	return "none"
+}
+
+// MarshalText spells b out in JSON and the like.
+func (b Band) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// Band returns the band t falls in for zone z. UTC is never colored.
+func (c *Config) Band(z Zone, t time.Time) Band {
+	t = t.In(z.Location)
+	m := t.Hour()*60 + t.Minute()
+
+	if abbrev, _ := t.Zone(); abbrev == UTC {
+		return None
+	}
+
+	b := None
+
+	// Tel Aviv:Asia/Jerusalem@Sun-Thu/9-18 overrides the globals, and its
+	// hours are kept out of the faint ones, e.g. for a night shift
+	greenHours, yellowHours, faintHours := c.GreenHours, c.YellowHours, c.FaintHours
+	if z.GreenHours != nil {
+		greenHours, yellowHours = z.GreenHours, z.YellowHours
+		faintHours = withoutHours(withoutHours(faintHours, greenHours), yellowHours)
+	}
+
+	today := c.Workday(z, t)
+	yesterday := c.Workday(z, t.AddDate(0, 0, -1))
+
+	// MTZDATE_GREEN_HOURS="8-17" -> GreenHours=[[480, 1020]]
+	if inHours(greenHours, m, today, yesterday) {
+		b = Green
+	}
+
+	// MTZDATE_YELLOW_HOURS="7-8,17-18" -> YellowHours=[[420, 480], [1020, 1080]]
+	if inHours(yellowHours, m, today, yesterday) {
+		b = Yellow
+	}
+
+	// MTZDATE_FAINT_HOURS="0-7,22-24" -> FaintHours=[[0, 420], [1320, 1440]]
+	if inHours(faintHours, m, true, true) {
+		b = Faint
+	}
+
+	return b
+}
+
+// Workday reports whether t falls on a workday in zone z that is not a public
+// holiday.
+func (c *Config) Workday(z Zone, t time.Time) bool {
+	workday := c.Workdays
+	if z.Workdays != nil {
+		workday = z.Workdays
+	}
+
+	_, holiday := c.Holiday(z, t)
+
+	return workday[t.In(z.Location).Format("Mon")] && !holiday
+}
+
+// inHours reports whether minute m of the day falls in one of hours, on a day
+// that counts. The part of a range that wraps past midnight belongs to the
+// day before, so a Fri 22:00-06:00 shift covers early Saturday.
+func inHours(hours [][]int, m int, today, yesterday bool) bool {
+	for _, r := range hours {
+		switch {
+		case r[0] <= r[1]:
+			if today && r[0] <= m && m < r[1] {
+				return true
+			}
+		case today && m >= r[0], yesterday && m < r[1]:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package timetable
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInHours(t *testing.T) {
+	day := [][]int{{480, 1020}}   // 8-17
+	night := [][]int{{1320, 360}} // 22-6
+
+	tests := []struct {
+		name             string
+		hours            [][]int
+		m                int
+		today, yesterday bool
+		want             bool
+	}{
+		{"day, start", day, 480, true, false, true},
+		{"day, end excluded", day, 1020, true, true, false},
+		{"day, before", day, 479, true, true, false},
+		{"day, day off", day, 600, false, true, false},
+		{"night, evening", night, 1380, true, false, true},
+		{"night, evening of a day off", night, 1380, false, true, false},
+		{"night, morning after a workday", night, 300, false, true, true},
+		{"night, morning after a day off", night, 300, true, false, false},
+		{"night, end excluded", night, 360, true, true, false},
+		{"night, midday", night, 720, true, true, false},
+		{"to midnight", [][]int{{1320, 1440}}, 1439, true, false, true},
+		{"none", nil, 600, true, true, false},
+	}
+	for _, tt := range tests {
+		if got := inHours(tt.hours, tt.m, tt.today, tt.yesterday); got != tt.want {
+			t.Errorf("%s: inHours(%v, %d, %v, %v) = %v, want %v",
+				tt.name, tt.hours, tt.m, tt.today, tt.yesterday, got, tt.want)
+		}
+	}
+}
+
+func TestWithoutHours(t *testing.T) {
+	faint := [][]int{{0, 420}, {1320, 1440}} // 0-7,22-24
+
+	tests := []struct {
+		hours, cut, want [][]int
+	}{
+		{faint, [][]int{{480, 1020}}, faint},
+		{faint, [][]int{{1320, 360}}, [][]int{{360, 420}}},
+		{faint, [][]int{{300, 1380}}, [][]int{{0, 300}, {1380, 1440}}},
+		{[][]int{{1320, 360}}, [][]int{{0, 60}}, [][]int{{1320, 1440}, {60, 360}}},
+		{faint, [][]int{{0, 1440}}, nil},
+	}
+	for _, tt := range tests {
+		if got := withoutHours(tt.hours, tt.cut); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("withoutHours(%v, %v) = %v, want %v", tt.hours, tt.cut, got, tt.want)
+		}
+	}
+}
+
+func TestBandGreenHoursOnly(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		green, at string
+		want      Band
+	}{
+		{"08:30-17:30", "2026-10-20 17:15", Green},
+		{"08:30-17:30", "2026-10-20 08:15", Yellow},
+		{"08:30-17:30", "2026-10-20 18:00", Yellow},
+		{"08:30-17:30", "2026-10-20 23:00", Faint},
+		{"22:00-06:00", "2026-10-20 23:00", Green},
+		{"22:00-06:00", "2026-10-21 05:30", Green},
+		{"22:00-06:00", "2026-10-20 21:30", Yellow},
+		{"22:00-06:00", "2026-10-21 06:30", Yellow},
+		{"22:00-06:00", "2026-10-24 03:00", Green}, // Friday's shift
+		{"22:00-06:00", "2026-10-25 03:00", None},
+	}
+	for _, tt := range tests {
+		c := New()
+		env := map[string]string{"MTZDATE_TIMEZONES": "Europe/Paris", "MTZDATE_GREEN_HOURS": tt.green}
+		if err := c.LoadEnv(func(k string) (string, bool) { v, ok := env[k]; return v, ok }); err != nil {
+			t.Fatalf("%s: %v", tt.green, err)
+		}
+
+		at, _ := time.ParseInLocation("2006-01-02 15:04", tt.at, paris)
+		if got := c.Band(c.Zones[0], at); got != tt.want {
+			t.Errorf("green hours %s at %s: got %s, want %s", tt.green, tt.at, got, tt.want)
+		}
+	}
+}
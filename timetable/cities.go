@@ -0,0 +1,139 @@
+package timetable
+
+import (
+	_ "embed" // cities.tsv
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// City is a place a time zone can be named after, e.g. "Bangalore" for
+// Asia/Kolkata.
+type City struct {
+	Name       string
+	Country    string
+	Zone       string
+	Population int
+}
+
+// cities.tsv lists cities that are not IANA time zone names, or are shared
+// by several places, one per line: "name|alternate...	CC	Zone	population".
+//
+//go:embed cities.tsv
+var citiesTSV string
+
+// cities maps lower-case city names and alternate names to their places.
+var cities = readCities(citiesTSV)
+
+func readCities(tsv string) map[string][]City {
+	m := make(map[string][]City)
+
+	for _, line := range strings.Split(tsv, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		f := strings.Split(line, "\t")
+		if len(f) != 4 {
+			continue
+		}
+
+		names := strings.Split(f[0], "|")
+		population, _ := strconv.Atoi(f[3])
+
+		for _, name := range names {
+			key := strings.ToLower(name)
+			m[key] = append(m[key], City{
+				Name:       names[0],
+				Country:    f[1],
+				Zone:       f[2],
+				Population: population,
+			})
+		}
+	}
+
+	return m
+}
+
+// LookupCity returns the places named s, ignoring case, most populous first.
+// Besides the bundled city table, the city part of every IANA time zone
+// counts, e.g. "kathmandu" or "New York" for Asia/Kathmandu and
+// America/New_York. Places sharing a time zone are listed once.
+func LookupCity(s string) []City {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	var found []City
+	seen := make(map[string]bool)
+
+	for _, city := range cities[strings.ToLower(s)] {
+		if !seen[city.Zone] {
+			seen[city.Zone] = true
+			found = append(found, city)
+		}
+	}
+
+	// New York -> New_York; backward-compatible links such as
+	// America/Buenos_Aires name the same place as the first match
+	name := strings.Replace(s, " ", "_", -1)
+	for _, zone := range ZoneNames() {
+		if !strings.EqualFold(dirPrefix.ReplaceAllString(zone, ""), name) {
+			continue
+		}
+
+		if !seen[zone] {
+			seen[zone] = true
+			found = append(found, City{
+				Name:    strings.Replace(dirPrefix.ReplaceAllString(zone, ""), "_", " ", -1),
+				Country: ZoneCountry(zone),
+				Zone:    zone,
+			})
+		}
+		break
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].Population > found[j].Population
+	})
+
+	return found
+}
+
+// ResolveCity picks the most populous place named s, as LookupCity finds
+// them, and returns the others that are at least a tenth as populous as
+// rivals, so that "Paris" goes to France without fuss while "Portland" is
+// worth a warning.
+func ResolveCity(s string) (city City, rivals []City, ok bool) {
+	found := LookupCity(s)
+	if len(found) == 0 {
+		return City{}, nil, false
+	}
+
+	for _, other := range found[1:] {
+		if other.Population > 0 && other.Population*10 >= found[0].Population {
+			rivals = append(rivals, other)
+		}
+	}
+
+	return found[0], rivals, true
+}
+
+// zoneAndCityNames lists the IANA time zones, their cities and the bundled
+// cities, as candidates for suggest.
+func zoneAndCityNames() []string {
+	var names []string
+
+	for _, zone := range ZoneNames() {
+		names = append(names, zone, strings.Replace(dirPrefix.ReplaceAllString(zone, ""), "_", " ", -1))
+	}
+	for _, places := range cities {
+		for _, city := range places {
+			names = append(names, city.Name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,90 @@
+// Package timetable renders the current date in multiple time zones, coloring
+// each row by the work-hour band it falls in.
+package timetable
+
+import (
+	"time"
+)
+
+// Built-in defaults, overridden by the MTZDATE_* environment variables.
+const (
+	UTC                = "UTC"
+	DefaultTimezones   = UTC
+	DefaultWorkdays    = "Mon,Tue,Wed,Thu,Fri"
+	DefaultGreenHours  = "8-17"
+	DefaultYellowHours = "7-8,17-18"
+	DefaultFaintHours  = "0-7,22-24"
+	DefaultFormat      = "dfc"
+)
+
+// Config holds everything Render needs to draw the time table.
+type Config struct {
+	// Zones are rendered in order, one row each.
+	Zones []Zone
+
+	// Workdays is keyed by abbreviated weekday name, e.g. "Mon".
+	Workdays map[string]bool
+
+	// Hour bands as half-open [start, end) ranges of minutes of the day, e.g.
+	// "7-8,17-18" -> [[420, 480], [1020, 1080]]; see ParseHours.
+	GreenHours  [][]int
+	YellowHours [][]int
+	FaintHours  [][]int
+
+	// Format is a sequence of "d" (date), "f" (flag), "c" (city), "o"
+	// (offset from Ref) and "t" (upcoming offset change, see DSTNote).
+	Format string
+
+	// Layout is the Go time layout of the "d" column, e.g. DefaultLayout;
+	// see ParseLayout for strftime patterns.
+	Layout string
+
+	// Locale names weekdays and months in the "d" column, and orders it
+	// unless Layout is set; nil means English. Zones may have their own.
+	Locale *Locale
+
+	// Theme colors the bands and the highlighted column.
+	Theme Theme
+
+	// Highlight is the letter of Format whose column Render shows in reverse
+	// video, if any.
+	Highlight rune
+
+	// Ref is the time zone that the "o" column is relative to; nil means
+	// time.Local.
+	Ref *time.Location
+
+	// Template, if set, is a text/template executed per Row by
+	// RenderTemplate, e.g. "{{.Flag}} {{.Label}} {{fmt \"15:04\" .Time}}".
+	Template string
+
+	// Holidays turn workdays into days off for zones with a Country.
+	Holidays Holidays
+
+	// Loop asks for the table to be refreshed once a second.
+	Loop bool
+
+	// DSTDays is how many days ahead the "t" column announces offset
+	// changes.
+	DSTDays int
+}
+
+// New returns a Config populated with the built-in defaults.
+func New() *Config {
+	c := &Config{
+		Workdays: ParseWorkdays(DefaultWorkdays),
+		Format:   DefaultFormat,
+		Layout:   DefaultLayout,
+		Holidays: loadBundledHolidays(),
+		DSTDays:  DefaultDSTDays,
+		Theme:    Themes[DefaultTheme],
+	}
+
+	// the defaults are known to parse
+	c.Zones, _ = ParseZones(DefaultTimezones)
+	c.GreenHours, _ = ParseHours(DefaultGreenHours)
+	c.YellowHours, _ = ParseHours(DefaultYellowHours)
+	c.FaintHours, _ = ParseHours(DefaultFaintHours)
+
+	return c
+}
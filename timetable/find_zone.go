@@ -0,0 +1,64 @@
+package timetable
+
+import (
+	"strings"
+	"time"
+)
+
+// ZoneIndex returns the index of s in c.Zones, matching labels and time zone
+// names while ignoring case, e.g. "München", "Europe/Berlin" or "Berlin", or
+// -1 if there is none.
+func (c *Config) ZoneIndex(s string) int {
+	for i, z := range c.Zones {
+		if strings.EqualFold(z.Label, s) ||
+			strings.EqualFold(z.Name, s) ||
+			strings.EqualFold(dirPrefix.ReplaceAllString(z.Name, ""), s) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindZone resolves s against c.Zones as ZoneIndex does. Failing that, s is
+// loaded as an IANA time zone or else looked up as a city, see ResolveCity.
+func (c *Config) FindZone(s string) (Zone, bool) {
+	if i := c.ZoneIndex(s); i >= 0 {
+		return c.Zones[i], true
+	}
+	if s == "" {
+		return Zone{}, false
+	}
+
+	if loc, err := time.LoadLocation(s); err == nil {
+		z := Zone{
+			Label:    dirPrefix.ReplaceAllString(s, ""),
+			Name:     s,
+			Flag:     Flag(s),
+			Country:  ZoneCountry(s),
+			Location: loc,
+		}
+		if z.Flag == "" && z.Country != "" {
+			z.Flag = Flag(z.Country)
+		}
+		return z, true
+	}
+
+	city, rivals, ok := ResolveCity(s)
+	if !ok {
+		return Zone{}, false
+	}
+
+	loc, err := time.LoadLocation(city.Zone)
+	if err != nil {
+		return Zone{}, false
+	}
+
+	return Zone{
+		Label:    city.Name,
+		Name:     city.Zone,
+		Flag:     Flag(city.Country),
+		Country:  city.Country,
+		Location: loc,
+		Rivals:   rivals,
+	}, true
+}
@@ -0,0 +1,34 @@
+package timetable
+
+import (
+	"time"
+)
+
+// formatTime formats t by layout in locale l, see Locale.Format, and styles
+// the time of day, from its first to its last element, e.g. in the color of
+// its band; a layout without a time of day is styled whole.
+func formatTime(t time.Time, layout string, l *Locale, style Style) string {
+	start, end := -1, -1
+
+	for i := 0; i < len(layout); {
+		n, clock := layoutElement(layout, i)
+		if n == 0 {
+			i++
+			continue
+		}
+
+		if clock {
+			if start < 0 {
+				start = i
+			}
+			end = i + n
+		}
+		i += n
+	}
+
+	if start < 0 {
+		return style.Sprint(l.Format(t, layout))
+	}
+
+	return l.Format(t, layout[:start]) + style.Sprint(l.Format(t, layout[start:end])) + l.Format(t, layout[end:])
+}
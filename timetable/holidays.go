@@ -0,0 +1,196 @@
+package timetable
+
+import (
+	"bufio"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Holiday calendars for a few countries, named after their ISO 3166-1
+// alpha-2 code, e.g. JP.json.
+//
+//go:embed holidays/*.json
+var bundledHolidays embed.FS
+
+// Holidays maps an ISO 3166-1 alpha-2 country code and a "2006-01-02" date to
+// the name of a public holiday.
+type Holidays map[string]map[string]string
+
+// Holiday returns the name of the public holiday t falls on in zone z, if any.
+func (c *Config) Holiday(z Zone, t time.Time) (string, bool) {
+	name, ok := c.Holidays[z.Country][t.In(z.Location).Format("2006-01-02")]
+	return name, ok
+}
+
+// loadBundledHolidays reads the calendars compiled into the binary.
+func loadBundledHolidays() Holidays {
+	h := make(Holidays)
+
+	files, _ := bundledHolidays.ReadDir("holidays")
+	for _, f := range files {
+		r, err := bundledHolidays.Open("holidays/" + f.Name())
+		if err != nil {
+			continue
+		}
+		_ = h.read(r, f.Name()) // nolint: errcheck
+		r.Close()               // nolint: errcheck
+	}
+
+	return h
+}
+
+// LoadHolidays merges the calendars at path into c.Holidays. path is a JSON
+// or iCalendar file named after a country code, e.g. JP.json or US.ics, or a
+// directory of such files.
+func (c *Config) LoadHolidays(path string) error {
+	if c.Holidays == nil {
+		c.Holidays = make(Holidays)
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{path}
+	if fi.IsDir() {
+		files, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+
+		paths = nil
+		for _, f := range files {
+			if ext := filepath.Ext(f.Name()); ext == ".json" || ext == ".ics" {
+				paths = append(paths, filepath.Join(path, f.Name()))
+			}
+		}
+	}
+
+	for _, p := range paths {
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+
+		err = c.Holidays.read(f, filepath.Base(p))
+		f.Close() // nolint: errcheck
+		if err != nil {
+			return fmt.Errorf("%s: %v", p, err)
+		}
+	}
+
+	return nil
+}
+
+// read parses a calendar for the country named by the base of name.
+func (h Holidays) read(r io.Reader, name string) error {
+	ext := filepath.Ext(name)
+	cc := strings.ToUpper(strings.TrimSuffix(name, ext))
+
+	if countryCode[cc] == "" {
+		return fmt.Errorf("unknown country code %q", cc)
+	}
+
+	if h[cc] == nil {
+		h[cc] = make(map[string]string)
+	}
+
+	switch ext {
+	case ".json":
+		return readJSONHolidays(r, h[cc])
+	case ".ics":
+		return readICSHolidays(r, h[cc])
+	}
+
+	return fmt.Errorf("unknown calendar format %q", ext)
+}
+
+// readJSONHolidays reads [{"date": "2026-01-01", "name": "New Year's Day"}].
+func readJSONHolidays(r io.Reader, days map[string]string) error {
+	var holidays []struct {
+		Date string `json:"date"`
+		Name string `json:"name"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&holidays); err != nil {
+		return err
+	}
+
+	for _, d := range holidays {
+		if _, err := time.Parse("2006-01-02", d.Date); err != nil {
+			return err
+		}
+		days[d.Date] = d.Name
+	}
+
+	return nil
+}
+
+// readICSHolidays reads the all-day VEVENTs of an iCalendar file, such as
+// those exported by most calendar applications.
+func readICSHolidays(r io.Reader, days map[string]string) error {
+	var (
+		start, end time.Time
+		summary    string
+	)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), "\r")
+
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		// DTSTART;VALUE=DATE:20260101 -> DTSTART
+		key := strings.SplitN(kv[0], ";", 2)[0]
+
+		switch key {
+		case "BEGIN":
+			start, end, summary = time.Time{}, time.Time{}, ""
+
+		case "DTSTART", "DTEND":
+			// 20260101 or 20260101T000000Z
+			date := kv[1]
+			if len(date) > 8 {
+				date = date[:8]
+			}
+
+			t, err := time.Parse("20060102", date)
+			if err != nil {
+				return err
+			}
+			if key == "DTSTART" {
+				start = t
+			} else {
+				end = t
+			}
+
+		case "SUMMARY":
+			summary = strings.Replace(kv[1], `\,`, ",", -1)
+
+		case "END":
+			if kv[1] != "VEVENT" || start.IsZero() {
+				continue
+			}
+
+			// DTEND is exclusive
+			if end.IsZero() {
+				end = start.AddDate(0, 0, 1)
+			}
+			for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+				days[d.Format("2006-01-02")] = summary
+			}
+		}
+	}
+
+	return s.Err()
+}
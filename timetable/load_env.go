@@ -0,0 +1,301 @@
+package timetable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// LookupFunc retrieves the value of a configuration variable; it has the
+// signature of os.LookupEnv so that the process environment can be used
+// directly, while tests and embedders can pass a map lookup instead.
+type LookupFunc func(key string) (string, bool)
+
+// Settings are the MTZDATE_* variables LoadEnv reads.
+var Settings = []string{
+	"MTZDATE_TIMEZONES",
+	"MTZDATE_FLAGS",
+	"MTZDATE_WORKDAYS",
+	"MTZDATE_GREEN_HOURS",
+	"MTZDATE_YELLOW_HOURS",
+	"MTZDATE_FAINT_HOURS",
+	"MTZDATE_THEME",
+	"MTZDATE_GREEN_STYLE",
+	"MTZDATE_YELLOW_STYLE",
+	"MTZDATE_FAINT_STYLE",
+	"MTZDATE_HIGHLIGHT_STYLE",
+	"MTZDATE_HOLIDAYS",
+	"MTZDATE_FORMAT",
+	"MTZDATE_TIME_LAYOUT",
+	"MTZDATE_LOCALE",
+	"MTZDATE_DST_DAYS",
+	"MTZDATE_REF",
+	"MTZDATE_TEMPLATE",
+	"MTZDATE_LOOP",
+}
+
+// LoadEnv overrides c with any MTZDATE_* variables known to lookup. Mistakes
+// such as a bogus time zone or a malformed hour range don't stop it: the
+// entry falls back to UTC or to the setting's previous value, and every such
+// Problem is returned together.
+func (c *Config) LoadEnv(lookup LookupFunc) error {
+	var problems Problems
+
+	if tz, ok := lookup("MTZDATE_TIMEZONES"); ok && tz != "" {
+		var err error
+		c.Zones, err = ParseZones(tz)
+		problems = append(problems, asProblems(err, tz).in("MTZDATE_TIMEZONES")...)
+	}
+
+	if flags, ok := lookup("MTZDATE_FLAGS"); ok {
+		problems = append(problems, asProblems(c.SetFlags(flags), flags).in("MTZDATE_FLAGS")...)
+	}
+
+	if wd, ok := lookup("MTZDATE_WORKDAYS"); ok {
+		c.Workdays = ParseWorkdays(wd)
+
+		for _, d := range strings.Split(wd, ",") {
+			if d != "" && !knownWeekday(d) {
+				problems = append(problems, Problem{
+					Var:        "MTZDATE_WORKDAYS",
+					Value:      d,
+					Msg:        "unknown weekday, want Sun, Mon, ...",
+					Suggestion: suggest(d, weekdayNames()),
+				})
+			}
+		}
+	}
+
+	if len(c.Workdays) == 0 {
+		// MTZDATE_WORKDAYS='' opts out of coloring altogether
+		c.GreenHours, c.YellowHours, c.FaintHours = nil, nil, nil
+	} else {
+		bands := []bandSetting{
+			{"MTZDATE_GREEN_HOURS", &c.GreenHours},
+			{"MTZDATE_YELLOW_HOURS", &c.YellowHours},
+			{"MTZDATE_FAINT_HOURS", &c.FaintHours},
+		}
+
+		set := make(map[string]bool)
+		for _, b := range bands {
+			if v, ok := lookup(b.env); ok {
+				hours, err := ParseHours(v)
+				if err != nil {
+					problems = append(problems, asProblems(err, v).in(b.env)...)
+					continue
+				}
+				*b.hours = hours
+				set[b.env] = true
+			}
+		}
+
+		// MTZDATE_GREEN_HOURS="22:00-06:00" alone brings its own yellow hours,
+		// and both are kept out of the faint hours unless those are set too
+		if set["MTZDATE_GREEN_HOURS"] && !set["MTZDATE_YELLOW_HOURS"] {
+			c.YellowHours = shoulderHours(c.GreenHours)
+		}
+		if (set["MTZDATE_GREEN_HOURS"] || set["MTZDATE_YELLOW_HOURS"]) && !set["MTZDATE_FAINT_HOURS"] {
+			c.FaintHours = withoutHours(withoutHours(c.FaintHours, c.GreenHours), c.YellowHours)
+		}
+
+		// later bands win, e.g. yellow over green, see Band; only the bands
+		// set by the user can be at odds with each other
+		for i, later := range bands {
+			for _, earlier := range bands[:i] {
+				if !set[later.env] || !set[earlier.env] {
+					continue
+				}
+				for _, r := range *later.hours {
+					for _, o := range *earlier.hours {
+						if overlaps(r, o) {
+							problems = append(problems, Problem{
+								Var:   later.env,
+								Value: formatHourRange(r),
+								Msg:   fmt.Sprintf("overlaps %s %s, which it overrides", earlier.name(), formatHourRange(o)),
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if name, ok := lookup("MTZDATE_THEME"); ok && name != "" {
+		if theme, ok := Themes[name]; ok {
+			c.Theme = theme
+		} else {
+			problems = append(problems, Problem{
+				Var:        "MTZDATE_THEME",
+				Value:      name,
+				Msg:        "unknown theme, want " + strings.Join(themeNames(), ", "),
+				Suggestion: suggest(name, themeNames()),
+			})
+		}
+	}
+
+	// MTZDATE_GREEN_STYLE="bold fg=#2e7d32" overrides the theme's green
+	styles := []struct {
+		env   string
+		style *Style
+	}{
+		{"MTZDATE_GREEN_STYLE", &c.Theme.Green},
+		{"MTZDATE_YELLOW_STYLE", &c.Theme.Yellow},
+		{"MTZDATE_FAINT_STYLE", &c.Theme.Faint},
+		{"MTZDATE_HIGHLIGHT_STYLE", &c.Theme.Highlight},
+	}
+
+	for _, s := range styles {
+		if v, ok := lookup(s.env); ok {
+			style, err := ParseStyle(v)
+			if err != nil {
+				problems = append(problems, asProblems(err, v).in(s.env)...)
+				continue
+			}
+			*s.style = style
+		}
+	}
+
+	if paths, ok := lookup("MTZDATE_HOLIDAYS"); ok && paths != "" {
+		for _, path := range strings.Split(paths, ",") {
+			if err := c.LoadHolidays(path); err != nil {
+				problems = append(problems, Problem{Var: "MTZDATE_HOLIDAYS", Value: path, Msg: err.Error()})
+			}
+		}
+	}
+
+	if format, ok := lookup("MTZDATE_FORMAT"); ok {
+		c.Format = format
+
+		for _, r := range format {
+			if !strings.ContainsRune("dfcot", r) {
+				problems = append(problems, Problem{
+					Var:   "MTZDATE_FORMAT",
+					Value: string(r),
+					Msg:   `unknown format letter, want "d", "f", "c", "o" or "t"`,
+				})
+			}
+		}
+	}
+
+	if layout, ok := lookup("MTZDATE_TIME_LAYOUT"); ok && layout != "" {
+		if l, err := ParseLayout(layout); err == nil {
+			c.Layout = l
+		} else {
+			problems = append(problems, Problem{Var: "MTZDATE_TIME_LAYOUT", Value: layout, Msg: err.Error()})
+		}
+	}
+
+	if locale, ok := lookup("MTZDATE_LOCALE"); ok && locale != "" {
+		if l, ok := LookupLocale(locale); ok {
+			c.Locale = l
+		} else {
+			problems = append(problems, Problem{
+				Var:        "MTZDATE_LOCALE",
+				Value:      locale,
+				Msg:        "unknown locale, want one of " + strings.Join(localeCodes(), ", "),
+				Suggestion: suggest(locale, localeCodes()),
+			})
+		}
+	}
+
+	if days, ok := lookup("MTZDATE_DST_DAYS"); ok && days != "" {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			c.DSTDays = n
+		} else {
+			problems = append(problems, Problem{Var: "MTZDATE_DST_DAYS", Value: days, Msg: "want a number of days"})
+		}
+	}
+
+	if ref, ok := lookup("MTZDATE_REF"); ok && ref != "" {
+		if z, ok := c.FindZone(ref); ok {
+			c.Ref = z.Location
+		} else {
+			problems = append(problems, Problem{
+				Var:        "MTZDATE_REF",
+				Value:      ref,
+				Msg:        "unknown time zone or city",
+				Suggestion: suggest(ref, zoneAndCityNames()),
+			})
+		}
+	}
+
+	if loop, ok := lookup("MTZDATE_LOOP"); ok {
+		c.Loop = loop != "" && loop != "0" && loop != "false"
+	}
+
+	if tmpl, ok := lookup("MTZDATE_TEMPLATE"); ok {
+		c.Template = tmpl
+
+		if _, err := template.New("MTZDATE_TEMPLATE").Funcs(templateFuncs).Parse(tmpl); err != nil {
+			problems = append(problems, Problem{Var: "MTZDATE_TEMPLATE", Value: tmpl, Msg: err.Error()})
+		}
+	}
+
+	return problems.err()
+}
+
+// bandSetting is the variable of a band's hours, e.g. MTZDATE_GREEN_HOURS.
+type bandSetting struct {
+	env   string
+	hours *[][]int
+}
+
+// name spells the band out wherever its hours came from, e.g. "green hours".
+func (b bandSetting) name() string {
+	return strings.ToLower(strings.Replace(strings.TrimPrefix(b.env, "MTZDATE_"), "_", " ", -1))
+}
+
+// knownWeekday reports whether d is spelled as Workday expects, e.g. "Mon".
+func knownWeekday(d string) bool {
+	for _, name := range weekdayNames() {
+		if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdayNames are "Sun" to "Sat".
+func weekdayNames() []string {
+	var names []string
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		names = append(names, d.String()[:3])
+	}
+	return names
+}
+
+// overlaps reports whether two minute-of-day ranges, either of which may wrap
+// past midnight, share a minute.
+func overlaps(a, b []int) bool {
+	for _, x := range unwrapHourRange(a) {
+		for _, y := range unwrapHourRange(b) {
+			if x[0] < y[1] && y[0] < x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unwrapHourRange splits [1320, 360] into [1320, 1440] and [0, 360].
+func unwrapHourRange(r []int) [][]int {
+	if r[0] <= r[1] {
+		return [][]int{r}
+	}
+	return [][]int{{r[0], 24 * 60}, {0, r[1]}}
+}
+
+// ParseWorkdays turns "Mon,Tue,Wed,Thu,Fri" into a set of weekday names.
+func ParseWorkdays(s string) map[string]bool {
+	workday := make(map[string]bool)
+
+	for _, v := range strings.Split(s, ",") {
+		if v != "" {
+			workday[v] = true
+		}
+	}
+
+	return workday
+}
@@ -0,0 +1,58 @@
+package timetable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoadEnvOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want []string
+	}{
+		{
+			name: "green hours alone",
+			env:  map[string]string{"MTZDATE_GREEN_HOURS": "08:30-17:30"},
+		},
+		{
+			name: "yellow hours alone",
+			env:  map[string]string{"MTZDATE_YELLOW_HOURS": "6-9"},
+		},
+		{
+			name: "night shift",
+			env:  map[string]string{"MTZDATE_GREEN_HOURS": "22:00-06:00"},
+		},
+		{
+			name: "both set",
+			env: map[string]string{
+				"MTZDATE_GREEN_HOURS":  "8-17",
+				"MTZDATE_YELLOW_HOURS": "7-9,17-18",
+			},
+			want: []string{`MTZDATE_YELLOW_HOURS: "7-9": overlaps green hours 8-17, which it overrides`},
+		},
+		{
+			name: "all set",
+			env: map[string]string{
+				"MTZDATE_GREEN_HOURS":  "8-17",
+				"MTZDATE_YELLOW_HOURS": "17-18",
+				"MTZDATE_FAINT_HOURS":  "0-9",
+			},
+			want: []string{`MTZDATE_FAINT_HOURS: "0-9": overlaps green hours 8-17, which it overrides`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().LoadEnv(func(k string) (string, bool) { v, ok := tt.env[k]; return v, ok })
+
+			var got []string
+			for _, p := range asProblems(err, "") {
+				got = append(got, p.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
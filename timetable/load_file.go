@@ -0,0 +1,313 @@
+package timetable
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LoadFile overrides c with the settings in a TOML config file, merged key by
+// key with the MTZDATE_* variables known to lookup, which take precedence.
+// Top-level keys mirror the variables in lower case, without the prefix;
+// arrays are joined with commas. Zones may also be spelled out as tables:
+//
+//	format   = "dfc"
+//	workdays = ["Mon", "Tue", "Wed", "Thu", "Fri"]
+//
+//	[[zones]]
+//	label  = "München"
+//	zone   = "Europe/Berlin"
+//	flag   = "DE"
+//	locale = "de"
+//
+//	[[zones]]
+//	label    = "Tel Aviv"
+//	zone     = "Asia/Jerusalem"
+//	workdays = "Sun-Thu"
+//	hours    = "9-18"
+//
+// Only the subset of TOML needed for the above is understood. Mistakes in the
+// settings are reported as by LoadEnv, naming the variable or else the file
+// and key the value came from.
+func (c *Config) LoadFile(path string, lookup LookupFunc) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // nolint: errcheck
+
+	file, unknown, err := parseConfigFile(bufio.NewScanner(f))
+	if err != nil {
+		return fmt.Errorf("%s:%v", path, err)
+	}
+
+	err = c.LoadEnv(func(key string) (string, bool) {
+		if v, ok := lookup(key); ok {
+			return v, true
+		}
+		v, ok := file[key]
+		return v, ok
+	})
+
+	// MTZDATE_GREEN_HOURS -> config.toml: green_hours, unless set in the env
+	problems := asProblems(err, "")
+	for i, p := range problems {
+		if _, env := lookup(p.Var); !env && strings.HasPrefix(p.Var, "MTZDATE_") {
+			problems[i].Var = path + ": " + strings.ToLower(strings.TrimPrefix(p.Var, "MTZDATE_"))
+		}
+	}
+
+	// 3 -> config.toml:3
+	for i := range unknown {
+		unknown[i].Var = path + ":" + unknown[i].Var
+	}
+
+	return append(unknown, problems...).err()
+}
+
+// zoneKeys are the keys of a [[zones]] table.
+var zoneKeys = []string{"flag", "hours", "label", "locale", "workdays", "zone"}
+
+// parseConfigFile maps the file onto MTZDATE_* variables so that LoadEnv
+// remains the single place where settings are interpreted. Keys that are not
+// settings, see Settings, are returned as Problems.
+func parseConfigFile(s *bufio.Scanner) (map[string]string, Problems, error) {
+	env := make(map[string]string)
+
+	var keys []string
+	for _, k := range Settings {
+		keys = append(keys, strings.ToLower(strings.TrimPrefix(k, "MTZDATE_")))
+	}
+
+	var (
+		zones   []map[string]string
+		table   map[string]string
+		n       int
+		unknown Problems
+	)
+
+	known := func(key string, keys []string, where string) bool {
+		for _, k := range keys {
+			if key == k {
+				return true
+			}
+		}
+		unknown = append(unknown, Problem{
+			Var:        strconv.Itoa(n),
+			Value:      key,
+			Msg:        "unknown key" + where,
+			Suggestion: suggest(key, keys),
+		})
+		return false
+	}
+
+	for s.Scan() {
+		n++
+		line := stripComment(s.Text())
+
+		// multi-line arrays
+		for openArray(line) && s.Scan() {
+			n++
+			line += " " + stripComment(s.Text())
+		}
+
+		switch {
+		case line == "":
+			continue
+
+		case line == "[[zones]]":
+			table = make(map[string]string)
+			zones = append(zones, table)
+			continue
+
+		case strings.HasPrefix(line, "["):
+			return nil, nil, fmt.Errorf("%d: unsupported table %s", n, line)
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, nil, fmt.Errorf("%d: want key = value", n)
+		}
+
+		key := strings.TrimSpace(kv[0])
+		value, err := parseConfigValue(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%d: %s: %v", n, key, err)
+		}
+
+		switch {
+		case table != nil:
+			if known(key, zoneKeys, " in [[zones]]") {
+				table[key] = value
+			}
+		case known(key, keys, ""):
+			env["MTZDATE_"+strings.ToUpper(key)] = value
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(zones) > 0 {
+		var tz, flags []string
+
+		for i, z := range zones {
+			if z["zone"] == "" {
+				return nil, nil, fmt.Errorf("zones[%d]: missing zone", i)
+			}
+
+			// the entry is spelled as for MTZDATE_TIMEZONES
+			if strings.ContainsAny(z["label"], ",:@") {
+				return nil, nil, fmt.Errorf("zones[%d]: label %q may not contain \",\", \":\" or \"@\"", i, z["label"])
+			}
+
+			entry := z["zone"]
+			if z["label"] != "" {
+				entry = z["label"] + ":" + entry
+			}
+			if z["workdays"] != "" || z["hours"] != "" {
+				// workdays = ["Sun-Thu"] -> "Sun-Thu"
+				entry += "@" + strings.Replace(z["workdays"], ",", "+", -1) + "/" + z["hours"]
+			}
+			if z["locale"] != "" {
+				entry += "@" + z["locale"]
+			}
+			tz = append(tz, entry)
+
+			if z["flag"] != "" {
+				label := z["label"]
+				if label == "" {
+					label = dirPrefix.ReplaceAllString(z["zone"], "")
+				}
+				flags = append(flags, label+":"+z["flag"])
+			}
+		}
+
+		env["MTZDATE_TIMEZONES"] = strings.Join(tz, ",")
+		if len(flags) > 0 {
+			env["MTZDATE_FLAGS"] = strings.Join(flags, ",")
+		}
+	}
+
+	return env, unknown, nil
+}
+
+// parseConfigValue accepts strings, bare words such as numbers and booleans,
+// and arrays thereof.
+func parseConfigValue(v string) (string, error) {
+	if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") {
+		var array []string
+
+		for _, e := range splitArray(v[1 : len(v)-1]) {
+			e = strings.TrimSpace(e)
+			if e == "" {
+				continue
+			}
+
+			_e, err := parseConfigValue(e)
+			if err != nil {
+				return "", err
+			}
+			array = append(array, _e)
+		}
+
+		return strings.Join(array, ","), nil
+	}
+
+	switch {
+	case strings.HasPrefix(v, `"`):
+		return strconv.Unquote(v)
+
+	case strings.HasPrefix(v, "'"):
+		if len(v) < 2 || !strings.HasSuffix(v, "'") {
+			return "", fmt.Errorf("unterminated string %s", v)
+		}
+		return v[1 : len(v)-1], nil
+	}
+
+	return v, nil
+}
+
+// splitArray splits on commas outside of quotes.
+func splitArray(s string) []string {
+	var (
+		array []string
+		quote rune
+		start int
+	)
+
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote && (quote == '\'' || i == 0 || s[i-1] != '\\') {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ',':
+			array = append(array, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(array, s[start:])
+}
+
+// openArray reports whether line assigns an array that it does not close,
+// counting brackets outside of quotes, e.g. `zones = [ "UTC",`.
+func openArray(line string) bool {
+	kv := strings.SplitN(line, "=", 2)
+	if len(kv) != 2 {
+		return false
+	}
+
+	value := strings.TrimSpace(kv[1])
+	if !strings.HasPrefix(value, "[") {
+		return false
+	}
+
+	var (
+		quote rune
+		depth int
+	)
+
+	for i, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote && (quote == '\'' || i == 0 || value[i-1] != '\\') {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		}
+	}
+
+	return depth > 0
+}
+
+// stripComment drops a trailing "# ..." outside of quotes.
+func stripComment(line string) string {
+	var quote rune
+
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote && (quote == '\'' || i == 0 || line[i-1] != '\\') {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#':
+			return strings.TrimSpace(line[:i])
+		}
+	}
+
+	return strings.TrimSpace(line)
+}
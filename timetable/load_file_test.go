@@ -0,0 +1,201 @@
+package timetable
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseConfigFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want map[string]string
+		bad  []string
+		err  string
+	}{
+		{
+			name: "strings, bare words and comments",
+			file: `
+# settings
+format = "dfc"     # columns
+dst_days = 3
+template = '{{.Label}} # not a comment'
+`,
+			want: map[string]string{
+				"MTZDATE_FORMAT":   "dfc",
+				"MTZDATE_DST_DAYS": "3",
+				"MTZDATE_TEMPLATE": "{{.Label}} # not a comment",
+			},
+		},
+		{
+			name: "arrays, across lines",
+			file: `
+workdays = ["Mon", "Tue",
+            "Wed", 'Thu', "Fri",]
+flags = ["Paris:FR", "Chicago:US"]
+`,
+			want: map[string]string{
+				"MTZDATE_WORKDAYS": "Mon,Tue,Wed,Thu,Fri",
+				"MTZDATE_FLAGS":    "Paris:FR,Chicago:US",
+			},
+		},
+		{
+			name: "brackets in strings",
+			file: `
+template = "[{{.Label}}"
+holidays = ["a]b.ics",
+            "c[d.ics"]
+format = "dfc"
+`,
+			want: map[string]string{
+				"MTZDATE_TEMPLATE": "[{{.Label}}",
+				"MTZDATE_HOLIDAYS": "a]b.ics,c[d.ics",
+				"MTZDATE_FORMAT":   "dfc",
+			},
+		},
+		{
+			name: "zone tables",
+			file: `
+[[zones]]
+label = "München"
+zone = "Europe/Berlin"
+flag = "DE"
+locale = "de"
+
+[[zones]]
+label = "Tel Aviv"
+zone = "Asia/Jerusalem"
+workdays = ["Sun-Thu"]
+hours = "9-18"
+
+[[zones]]
+zone = "America/Chicago"
+flag = "US"
+`,
+			want: map[string]string{
+				"MTZDATE_TIMEZONES": "München:Europe/Berlin@de,Tel Aviv:Asia/Jerusalem@Sun-Thu/9-18,America/Chicago",
+				"MTZDATE_FLAGS":     "München:DE,Chicago:US",
+			},
+		},
+		{
+			name: "unknown keys",
+			file: `
+timezone = "Asia/Tokyo"
+loop = 1
+
+[[zones]]
+labl = "Home"
+zone = "UTC"
+`,
+			want: map[string]string{
+				"MTZDATE_LOOP":      "1",
+				"MTZDATE_TIMEZONES": "UTC",
+			},
+			bad: []string{
+				`2: "timezone": unknown key; did you mean "timezones"?`,
+				`6: "labl": unknown key in [[zones]]; did you mean "label"?`,
+			},
+		},
+		{
+			name: "missing zone",
+			file: "[[zones]]\nlabel = \"Home\"\n",
+			err:  "zones[0]: missing zone",
+		},
+		{
+			name: "label with a separator",
+			file: "[[zones]]\nlabel = \"Paris, Texas\"\nzone = \"America/Chicago\"\n",
+			err:  `zones[0]: label "Paris, Texas" may not contain ",", ":" or "@"`,
+		},
+		{
+			name: "label with an at sign",
+			file: "[[zones]]\nlabel = \"HQ@home\"\nzone = \"UTC\"\n",
+			err:  `zones[0]: label "HQ@home" may not contain ",", ":" or "@"`,
+		},
+		{
+			name: "unsupported table",
+			file: "[colors]\n",
+			err:  "1: unsupported table [colors]",
+		},
+		{
+			name: "no value",
+			file: "\nformat\n",
+			err:  "2: want key = value",
+		},
+		{
+			name: "unterminated string",
+			file: "format = 'dfc\n",
+			err:  "1: format: unterminated string 'dfc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unknown, err := parseConfigFile(bufio.NewScanner(strings.NewReader(tt.file)))
+
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			var bad []string
+			for _, p := range unknown {
+				bad = append(bad, p.Error())
+			}
+			if !reflect.DeepEqual(bad, tt.bad) {
+				t.Errorf("unknown keys %q, want %q", bad, tt.bad)
+			}
+		})
+	}
+}
+
+func TestSplitArray(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{``, []string{""}},
+		{`"a"`, []string{`"a"`}},
+		{`"a", "b"`, []string{`"a"`, ` "b"`}},
+		{`"a,b", 'c,d'`, []string{`"a,b"`, ` 'c,d'`}},
+		{`"a\",b", c`, []string{`"a\",b"`, ` c`}},
+		{`'a\', b`, []string{`'a\'`, ` b`}},
+		{`1, 2,`, []string{`1`, ` 2`, ``}},
+	}
+
+	for _, tt := range tests {
+		if got := splitArray(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitArray(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestStripComment(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{``, ``},
+		{`# all comment`, ``},
+		{`format = "dfc"`, `format = "dfc"`},
+		{`format = "dfc"  # columns `, `format = "dfc"`},
+		{`label = "#1"`, `label = "#1"`},
+		{`label = '#1' # first`, `label = '#1'`},
+		{`label = "say \"#1\"" # quoted`, `label = "say \"#1\""`},
+		{`  spaced = 1  `, `spaced = 1`},
+	}
+
+	for _, tt := range tests {
+		if got := stripComment(tt.in); got != tt.want {
+			t.Errorf("stripComment(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package timetable
+
+import (
+	_ "embed" // locales.tsv
+	"sort"
+	"strings"
+	"time"
+)
+
+// Locale names weekdays and months, and orders the "d" column, as is usual
+// in a language, e.g. "Mo. 30. Juli" in German.
+type Locale struct {
+	// Code is the BCP 47 language tag, e.g. "de" or "en-GB".
+	Code string
+
+	// Layout replaces DefaultLayout in the "d" column, e.g.
+	// "Mon 2. Jan 15:04:05 MST".
+	Layout string
+
+	ShortDays   [7]string
+	Days        [7]string
+	ShortMonths [12]string
+	Months      [12]string
+	AM, PM      string
+}
+
+//go:embed locales.tsv
+var localesTSV string
+
+// locales maps lower-case language tags to their Locale.
+var locales = readLocales(localesTSV)
+
+func readLocales(tsv string) map[string]*Locale {
+	m := make(map[string]*Locale)
+
+	for _, line := range strings.Split(tsv, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		f := strings.Split(line, "\t")
+		if len(f) != 7 {
+			continue
+		}
+
+		l := &Locale{Code: f[0], Layout: f[1]}
+		copy(l.ShortDays[:], strings.Split(f[2], "|"))
+		copy(l.Days[:], strings.Split(f[3], "|"))
+		copy(l.ShortMonths[:], strings.Split(f[4], "|"))
+		copy(l.Months[:], strings.Split(f[5], "|"))
+		if ampm := strings.Split(f[6], "|"); len(ampm) == 2 {
+			l.AM, l.PM = ampm[0], ampm[1]
+		}
+
+		m[strings.ToLower(l.Code)] = l
+	}
+
+	return m
+}
+
+// LookupLocale returns the bundled Locale for a language tag such as "ja",
+// "de-AT" or "de_DE.UTF-8", falling back from the region to the language.
+func LookupLocale(s string) (*Locale, bool) {
+	// de_DE.UTF-8 -> de-de
+	if i := strings.IndexAny(s, ".@"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.ToLower(strings.Replace(strings.TrimSpace(s), "_", "-", -1))
+
+	if l, ok := locales[s]; ok {
+		return l, true
+	}
+	if i := strings.Index(s, "-"); i >= 0 {
+		l, ok := locales[s[:i]]
+		return l, ok
+	}
+	return nil, false
+}
+
+// localeCodes lists the bundled locales, as candidates for suggest.
+func localeCodes() []string {
+	var codes []string
+	for _, l := range locales {
+		codes = append(codes, l.Code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Format formats t by a Go layout, naming weekdays, months and AM/PM as l
+// does. A nil Locale formats as time.Format.
+func (l *Locale) Format(t time.Time, layout string) string {
+	if l == nil {
+		return t.Format(layout)
+	}
+
+	var s strings.Builder
+
+	// literal text and elements other than names go to time.Format
+	start := 0
+	for i := 0; i < len(layout); {
+		n, _ := layoutElement(layout, i)
+		if n == 0 {
+			i++
+			continue
+		}
+
+		name, ok := "", true
+		switch layout[i : i+n] {
+		case "Mon":
+			name = l.ShortDays[t.Weekday()]
+		case "Monday":
+			name = l.Days[t.Weekday()]
+		case "Jan":
+			name = l.ShortMonths[t.Month()-1]
+		case "January":
+			name = l.Months[t.Month()-1]
+		case "PM":
+			name = l.AM
+			if t.Hour() >= 12 {
+				name = l.PM
+			}
+		case "pm":
+			name = l.AM
+			if t.Hour() >= 12 {
+				name = l.PM
+			}
+			name = strings.ToLower(name)
+		default:
+			ok = false
+		}
+
+		if ok {
+			s.WriteString(t.Format(layout[start:i]) + name)
+			start = i + n
+		}
+		i += n
+	}
+	s.WriteString(t.Format(layout[start:]))
+
+	return s.String()
+}
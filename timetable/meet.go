@@ -0,0 +1,139 @@
+package timetable
+
+import (
+	"sort"
+	"time"
+)
+
+// MeetOptions controls the search performed by Meet.
+type MeetOptions struct {
+	// From and To bound the start of the meeting.
+	From, To time.Time
+
+	// Step is the granularity at which start times are tried; bands are
+	// sampled every minute of the meeting.
+	Step time.Duration
+
+	Duration time.Duration
+
+	// Required zones must be in their green or yellow hours for the whole
+	// meeting; Optional zones only add to the score. Both index c.Zones.
+	Required, Optional []int
+
+	// Top is the maximum number of windows returned.
+	Top int
+}
+
+// Window is a run of equally good meeting start times.
+type Window struct {
+	// Start and End bound the start of the meeting; the last meeting that fits
+	// the window ends at End+Duration.
+	Start, End time.Time
+
+	Score int
+
+	// Bands holds the worst band of each zone in c.Zones over a meeting,
+	// or None for zones that were not considered.
+	Bands []Band
+}
+
+// score ranks the worst band of a zone over a meeting.
+var score = map[Band]int{
+	Green:  3,
+	Yellow: 2,
+	None:   1,
+	Faint:  0,
+}
+
+// Meet scans o.From to o.To for meeting slots and returns the best windows,
+// best first. Required zones count double.
+func (c *Config) Meet(o MeetOptions) []Window {
+	var windows []Window
+
+	if o.Step <= 0 {
+		o.Step = 15 * time.Minute
+	}
+
+	from := o.From.Truncate(o.Step)
+	if from.Before(o.From) {
+		from = from.Add(o.Step)
+	}
+
+	for t := from; !t.After(o.To); t = t.Add(o.Step) {
+		bands, total, ok := c.scoreSlot(t, o)
+		if !ok {
+			continue
+		}
+
+		// extend the current window while nothing changes
+		if n := len(windows); n > 0 &&
+			windows[n-1].End.Add(o.Step).Equal(t) &&
+			sameBands(windows[n-1].Bands, bands) {
+			windows[n-1].End = t
+			continue
+		}
+
+		windows = append(windows, Window{
+			Start: t,
+			End:   t,
+			Score: total,
+			Bands: bands,
+		})
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool {
+		if windows[i].Score != windows[j].Score {
+			return windows[i].Score > windows[j].Score
+		}
+		return windows[i].End.Sub(windows[i].Start) > windows[j].End.Sub(windows[j].Start)
+	})
+
+	if o.Top > 0 && len(windows) > o.Top {
+		windows = windows[:o.Top]
+	}
+
+	return windows
+}
+
+// scoreSlot samples every zone from t to t+o.Duration, rejecting the slot if a
+// required zone is out of hours.
+func (c *Config) scoreSlot(t time.Time, o MeetOptions) ([]Band, int, bool) {
+	bands := make([]Band, len(c.Zones))
+	total := 0
+
+	// every minute, as hours and UTC offsets may change at any minute, e.g.
+	// at 17:00 in a +0545 zone
+	worst := func(i int) Band {
+		b := Green
+		for s := t; s.Before(t.Add(o.Duration)) || s.Equal(t); s = s.Add(time.Minute) {
+			if _b := c.Band(c.Zones[i], s); score[_b] < score[b] {
+				b = _b
+			}
+		}
+		return b
+	}
+
+	for _, i := range o.Required {
+		bands[i] = worst(i)
+		if bands[i] != Green && bands[i] != Yellow {
+			return nil, 0, false
+		}
+		total += 2 * score[bands[i]]
+	}
+
+	for _, i := range o.Optional {
+		bands[i] = worst(i)
+		total += score[bands[i]]
+	}
+
+	return bands, total, true
+}
+
+func sameBands(a, b []Band) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package timetable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMeet(t *testing.T) {
+	c := New()
+	var err error
+	if c.Zones, err = ParseZones("Asia/Kathmandu"); err != nil {
+		t.Skip(err)
+	}
+	loc := c.Zones[0].Location
+
+	from := time.Date(2026, time.October, 20, 0, 0, 0, 0, loc)
+	windows := c.Meet(MeetOptions{
+		From:     from,
+		To:       from.Add(24 * time.Hour),
+		Step:     time.Hour,
+		Duration: 90 * time.Minute,
+		Required: []int{0},
+	})
+	if len(windows) == 0 {
+		t.Fatal("no windows")
+	}
+
+	// the hourly starts fall at :45 local time; one at 15:45 would run into
+	// the yellow hours at 17:00
+	best := windows[0]
+	start, end := best.Start.In(loc).Format("15:04"), best.End.In(loc).Format("15:04")
+	if start != "08:45" || end != "14:45" || best.Bands[0] != Green {
+		t.Errorf("best window %s-%s %s, want 08:45-14:45 green", start, end, best.Bands[0])
+	}
+
+	for _, w := range windows {
+		last := w.End.Add(90 * time.Minute).In(loc)
+		if w.Bands[0] == Green && c.Band(c.Zones[0], last.Add(-time.Minute)) != Green {
+			t.Errorf("window %s-%s is green but its last meeting ends at %s", w.Start.In(loc).Format("15:04"), w.End.In(loc).Format("15:04"), last.Format("15:04"))
+		}
+	}
+}
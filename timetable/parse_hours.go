@@ -0,0 +1,164 @@
+package timetable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseHours turns a comma-separated list of H[:MM]-H[:MM] ranges into bands
+// of minutes of the day, e.g. "7-8:30,17:30-18" -> [[420, 510], [1050, 1080]].
+// A range may wrap past midnight, e.g. "22:00-06:00" -> [[1320, 360]].
+// Every malformed range is reported in the returned Problems.
+func ParseHours(s string) ([][]int, error) {
+	var array [][]int
+	var problems Problems
+
+	if s == "" {
+		return array, nil
+	}
+
+	for _, r := range strings.Split(s, ",") {
+		v, err := parseHourRange(r)
+		if err != nil {
+			p := Problem{Value: r, Msg: err.Error()}
+
+			// 8-l7 -> 8-17
+			if fixed := lookalikeDigits.Replace(r); fixed != r {
+				if _, err := parseHourRange(fixed); err == nil {
+					p.Suggestion = fixed
+				}
+			}
+
+			problems = append(problems, p)
+			continue
+		}
+		array = append(array, v)
+	}
+
+	return array, problems.err()
+}
+
+// lookalikeDigits undoes the usual typos in hour ranges.
+var lookalikeDigits = strings.NewReplacer(
+	"l", "1", "I", "1", "i", "1", "|", "1",
+	"O", "0", "o", "0",
+	".", ":", ";", ":",
+	" ", "",
+)
+
+// parseHourRange turns "7-8:30" into [420, 510].
+func parseHourRange(r string) ([]int, error) {
+	var v []int
+	for _, _u := range strings.Split(r, "-") {
+		_v, err := parseMinuteOfDay(_u)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, _v)
+	}
+	if len(v) != 2 {
+		return nil, fmt.Errorf("bad hour range: want START-END")
+	}
+	return v, nil
+}
+
+// parseMinuteOfDay turns "8", "08:30" or "24:00" into minutes since midnight.
+func parseMinuteOfDay(s string) (int, error) {
+	hm := strings.Split(s, ":")
+	if len(hm) > 2 {
+		return 0, fmt.Errorf("bad time of day %q: want H or HH:MM", s)
+	}
+
+	h, err := strconv.Atoi(hm[0])
+	if err != nil {
+		return 0, fmt.Errorf("bad time of day %q: want H or HH:MM", s)
+	}
+
+	m := 0
+	if len(hm) == 2 {
+		if len(hm[1]) != 2 {
+			return 0, fmt.Errorf("bad time of day %q: want HH:MM", s)
+		}
+		if m, err = strconv.Atoi(hm[1]); err != nil {
+			return 0, fmt.Errorf("bad time of day %q: want HH:MM", s)
+		}
+	}
+
+	if h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, fmt.Errorf("bad time of day %q: out of range", s)
+	}
+
+	return h*60 + m, nil
+}
+
+// FormatHours turns bands of minutes of the day back into the form ParseHours
+// reads, e.g. [[420, 510], [1050, 1080]] -> "7-8:30,17:30-18".
+func FormatHours(hours [][]int) string {
+	var ranges []string
+	for _, r := range hours {
+		ranges = append(ranges, formatHourRange(r))
+	}
+	return strings.Join(ranges, ",")
+}
+
+// formatHourRange turns [420, 510] back into "7-8:30".
+func formatHourRange(r []int) string {
+	var ends []string
+	for _, m := range r {
+		if m%60 == 0 {
+			ends = append(ends, strconv.Itoa(m/60))
+		} else {
+			ends = append(ends, fmt.Sprintf("%d:%02d", m/60, m%60))
+		}
+	}
+	return strings.Join(ends, "-")
+}
+
+// shoulderHours are the hour before and the hour after each of green, e.g.
+// [[480, 1020]] -> [[420, 480], [1020, 1080]].
+func shoulderHours(green [][]int) [][]int {
+	var yellow [][]int
+	for _, h := range green {
+		yellow = append(yellow,
+			[]int{(h[0] + 23*60) % (24 * 60), h[0]},
+			[]int{h[1] % (24 * 60), (h[1] + 60) % (24 * 60)},
+		)
+	}
+	return yellow
+}
+
+// withoutHours takes the minutes of cut out of hours, e.g. [[0, 420],
+// [1320, 1440]] without [[1320, 360]] -> [[360, 420]]. Ranges that wrap past
+// midnight are split there first.
+func withoutHours(hours, cut [][]int) [][]int {
+	var left [][]int
+	for _, r := range hours {
+		left = append(left, unwrapHourRange(r)...)
+	}
+
+	for _, c := range cut {
+		for _, x := range unwrapHourRange(c) {
+			var next [][]int
+			for _, r := range left {
+				if r[0] < x[0] {
+					end := r[1]
+					if x[0] < end {
+						end = x[0]
+					}
+					next = append(next, []int{r[0], end})
+				}
+				if x[1] < r[1] {
+					start := r[0]
+					if x[1] > start {
+						start = x[1]
+					}
+					next = append(next, []int{start, r[1]})
+				}
+			}
+			left = next
+		}
+	}
+
+	return left
+}
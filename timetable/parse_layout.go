@@ -0,0 +1,193 @@
+package timetable
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultLayout is the "d" column as date(1) shows it, without the year.
+const DefaultLayout = "Mon Jan _2 15:04:05 MST"
+
+// strftime maps the conversions of strftime(3) onto Go layout elements; see
+// unsupported for those without a counterpart.
+var strftime = map[byte]string{
+	'a': "Mon",
+	'A': "Monday",
+	'b': "Jan",
+	'B': "January",
+	'c': "Mon Jan _2 15:04:05 2006",
+	'd': "02",
+	'D': "01/02/06",
+	'e': "_2",
+	'F': "2006-01-02",
+	'h': "Jan",
+	'H': "15",
+	'I': "03",
+	'j': "002",
+	'm': "01",
+	'M': "04",
+	'n': "\n",
+	'p': "PM",
+	'P': "pm",
+	'r': "03:04:05 PM",
+	'R': "15:04",
+	'S': "05",
+	't': "\t",
+	'T': "15:04:05",
+	'x': "01/02/06",
+	'X': "15:04:05",
+	'y': "06",
+	'Y': "2006",
+	'z': "-0700",
+	'Z': "MST",
+	'%': "%",
+}
+
+// unsupported are the conversions of strftime(3) that Go cannot format, and
+// why.
+var unsupported = map[byte]string{
+	'C': "Go has no century",
+	'k': "Go has no blank-padded 24-hour hour",
+	'l': "Go has no blank-padded 12-hour hour",
+}
+
+// ParseLayout accepts a Go time layout, e.g. "Mon 15:04 MST", or a strftime
+// pattern, e.g. "%a %H:%M %Z", told apart by the "%", and returns the Go
+// layout. Text between the conversions of a strftime pattern is kept as is,
+// so it is best kept free of digits and of words such as "Mon" or "PM" that
+// Go would read as layout elements.
+func ParseLayout(s string) (string, error) {
+	if !strings.Contains(s, "%") {
+		for i := 0; i < len(s); i++ {
+			if n, _ := layoutElement(s, i); n > 0 {
+				return s, nil
+			}
+		}
+		return "", fmt.Errorf(`no date or time: want a Go layout, e.g. "Mon 15:04 MST", or a strftime pattern, e.g. "%%a %%H:%%M %%Z"`)
+	}
+
+	var layout strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			layout.WriteByte(s[i])
+			continue
+		}
+
+		if i+1 == len(s) {
+			return "", fmt.Errorf("dangling %%")
+		}
+		i++
+
+		// %:z -> -07:00
+		if s[i] == ':' && i+1 < len(s) && s[i+1] == 'z' {
+			layout.WriteString("-07:00")
+			i++
+			continue
+		}
+
+		if why, ok := unsupported[s[i]]; ok {
+			return "", fmt.Errorf("unsupported conversion %%%c: %s", s[i], why)
+		}
+
+		element, ok := strftime[s[i]]
+		if !ok {
+			return "", fmt.Errorf("unknown conversion %%%c", s[i])
+		}
+		layout.WriteString(element)
+	}
+
+	return layout.String(), nil
+}
+
+// layoutElement returns the length of the Go layout element at s[i], as
+// time.Format reads it, or 0 for literal text, and whether it is part of the
+// time of day.
+func layoutElement(s string, i int) (n int, clock bool) {
+	has := func(e string) bool { return strings.HasPrefix(s[i:], e) }
+	digit := func(j int) bool { return j < len(s) && '0' <= s[j] && s[j] <= '9' }
+
+	switch s[i] {
+	case 'J':
+		switch {
+		case has("January"):
+			return 7, false
+		case has("Jan"):
+			return 3, false
+		}
+
+	case 'M':
+		switch {
+		case has("Monday"):
+			return 6, false
+		case has("Mon"), has("MST"):
+			return 3, false
+		}
+
+	case '0':
+		switch {
+		case has("03"), has("04"), has("05"):
+			return 2, true
+		case has("01"), has("02"), has("06"):
+			return 2, false
+		case has("002"):
+			return 3, false
+		}
+
+	case '1':
+		if has("15") {
+			return 2, true
+		}
+		return 1, false
+
+	case '2':
+		if has("2006") {
+			return 4, false
+		}
+		return 1, false
+
+	case '_':
+		switch {
+		case has("_2006"):
+			// a literal "_" before the year
+		case has("__2"):
+			return 3, false
+		case has("_2"):
+			return 2, false
+		}
+
+	case '3', '4', '5':
+		return 1, true
+
+	case 'P':
+		if has("PM") {
+			return 2, true
+		}
+
+	case 'p':
+		if has("pm") {
+			return 2, true
+		}
+
+	case '-', 'Z':
+		for _, e := range []string{"070000", "07:00:00", "0700", "07:00", "07"} {
+			if has(s[i:i+1] + e) {
+				return 1 + len(e), false
+			}
+		}
+
+	case '.', ',':
+		// fractional seconds, e.g. ".000" or ",999"
+		if i+1 < len(s) && (s[i+1] == '0' || s[i+1] == '9') {
+			j := i + 1
+			for j < len(s) && s[j] == s[i+1] {
+				j++
+			}
+			if !digit(j) {
+				return j - i, true
+			}
+		}
+	}
+
+	return 0, false
+}
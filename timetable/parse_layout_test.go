@@ -0,0 +1,80 @@
+package timetable
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLayoutElement(t *testing.T) {
+	at := time.Date(2026, time.March, 7, 9, 5, 4, 123456789, time.FixedZone("ACST", 34200))
+
+	tests := []struct {
+		layout string
+		clock  []string // the elements of the time of day, in order
+	}{
+		{DefaultLayout, []string{"15", "04", "05"}},
+		{time.ANSIC, []string{"15", "04", "05"}},
+		{time.RFC1123Z, []string{"15", "04", "05"}},
+		{time.RFC3339Nano, []string{"15", "04", "05", ".999999999"}},
+		{time.Kitchen, []string{"3", "04", "PM"}},
+		{"2006-01-02 03:04:05.000 pm -07:00:00", []string{"03", "04", "05", ".000", "pm"}},
+		{"Monday, January _2 __2 002 Z07:00", nil},
+		{"1/2/06 at 4:5", []string{"4", "5"}},
+		{"_2006 Jun Mo 0 10 .5 ,000", []string{"5", ",000"}},
+		{"15h04 -0700 Z070000 -07", []string{"15", "04"}},
+	}
+	for _, tt := range tests {
+		var (
+			formatted strings.Builder
+			clock     []string
+		)
+		for i := 0; i < len(tt.layout); {
+			n, c := layoutElement(tt.layout, i)
+			if n == 0 {
+				n = 1
+			} else if c {
+				clock = append(clock, tt.layout[i:i+n])
+			}
+			formatted.WriteString(at.Format(tt.layout[i : i+n]))
+			i += n
+		}
+
+		if got, want := formatted.String(), at.Format(tt.layout); got != want {
+			t.Errorf("%q: segments format as %q, want %q", tt.layout, got, want)
+		}
+		if strings.Join(clock, " ") != strings.Join(tt.clock, " ") {
+			t.Errorf("%q: clock elements %q, want %q", tt.layout, clock, tt.clock)
+		}
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	tests := []struct {
+		in, want, err string
+	}{
+		{in: DefaultLayout, want: DefaultLayout},
+		{in: "%a %H:%M %Z", want: "Mon 15:04 MST"},
+		{in: "%F %T%:z", want: "2006-01-02 15:04:05-07:00"},
+		{in: "%I:%M %p, %e %b", want: "03:04 PM, _2 Jan"},
+		{in: "100%%", want: "100%"},
+		{in: "at noon", err: "no date or time"},
+		{in: "%H:%", err: "dangling %"},
+		{in: "%H:%Q", err: "unknown conversion %Q"},
+		{in: "%C", err: "unsupported conversion %C"},
+		{in: "%k:%M", err: "unsupported conversion %k"},
+		{in: "%l:%M", err: "unsupported conversion %l"},
+	}
+	for _, tt := range tests {
+		got, err := ParseLayout(tt.in)
+		if tt.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("ParseLayout(%q) error = %v, want %q", tt.in, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseLayout(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
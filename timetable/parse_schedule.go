@@ -0,0 +1,58 @@
+package timetable
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseSchedule sets the zone's own workdays and green hours from
+// "DAYS/HOURS", either of which may be left out, e.g. "Sun-Thu/9-18",
+// "Sun-Thu" or "/10-19". DAYS is a "+"-separated list of weekdays or ranges
+// of weekdays, e.g. "Mon-Wed+Fri". The yellow hours become the hour before
+// and the hour after each green range, as with the defaults.
+func (z *Zone) ParseSchedule(s string) error {
+	days, hours := s, ""
+	if i := strings.Index(s, "/"); i >= 0 {
+		days, hours = s[:i], s[i+1:]
+	}
+
+	if days != "" {
+		z.Workdays = make(map[string]bool)
+
+		for _, r := range strings.Split(days, "+") {
+			ends := strings.Split(r, "-")
+			if len(ends) > 2 {
+				return fmt.Errorf("bad weekday range %q", r)
+			}
+
+			var wd []time.Weekday
+			for _, e := range ends {
+				d, ok := weekdays[strings.ToLower(e)]
+				if len(e) != 3 || !ok {
+					return fmt.Errorf("bad weekday %q: want Sun, Mon, ...", e)
+				}
+				wd = append(wd, d)
+			}
+
+			// Sun-Thu; Fri-Mon wraps around the weekend
+			for d := wd[0]; ; d = (d + 1) % 7 {
+				z.Workdays[d.String()[:3]] = true
+				if d == wd[len(wd)-1] {
+					break
+				}
+			}
+		}
+	}
+
+	if hours != "" {
+		var err error
+		if z.GreenHours, err = ParseHours(hours); err != nil {
+			return err
+		}
+
+		z.YellowHours = shoulderHours(z.GreenHours)
+	}
+
+	return nil
+}
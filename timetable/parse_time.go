@@ -0,0 +1,166 @@
+package timetable
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	clock   = regexp.MustCompile(`^(\d{1,2}):(\d{2})(?::(\d{2}))?$`)
+	isoDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+	weekdays = map[string]time.Weekday{
+		"sun": time.Sunday,
+		"mon": time.Monday,
+		"tue": time.Tuesday,
+		"wed": time.Wednesday,
+		"thu": time.Thursday,
+		"fri": time.Friday,
+		"sat": time.Saturday,
+	}
+)
+
+// ParseTime parses s as an instant, relative to now where needed. Accepted
+// forms are RFC 3339, Unix seconds after an "@" as with date -d, e.g.
+// "@1700000000", and a sequence of
+//
+//	now | today | tomorrow | yesterday   day, relative to now
+//	[next] Mon | Monday                 upcoming weekday
+//	2006-01-02                          date
+//	15:04[:05]                          wall-clock time
+//	+90m | -1h                          offset
+//
+// followed or preceded by a zone label or IANA name as resolved by FindZone,
+// e.g. "tomorrow 15:00 Europe/Paris" or "15:00 München". Without a zone, the
+// local time zone is assumed.
+func (c *Config) ParseTime(s string, now time.Time) (time.Time, error) {
+	t, _, err := c.ResolveTime(s, now, time.Local)
+	return t, err
+}
+
+// ResolveTime parses s as ParseTime does, but assumes loc rather than the
+// local time zone when s names none, and also says how it resolved a
+// wall-clock time that a change of UTC offset skips or repeats, see
+// WallClock; note is "" otherwise.
+func (c *Config) ResolveTime(s string, now time.Time, loc *time.Location) (t time.Time, note string, err error) {
+	s = strings.TrimSpace(s)
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, "", nil
+	}
+
+	// @1700000000; a bare number is more likely a mistyped hour
+	if strings.HasPrefix(s, "@") {
+		sec, err := strconv.ParseInt(s[1:], 10, 64)
+		if err != nil {
+			return time.Time{}, "", fmt.Errorf("bad Unix time %q: want @ and a number of seconds", s)
+		}
+		return time.Unix(sec, 0), "", nil
+	}
+
+	var (
+		days, weekday, hms []int
+		date               string
+		offset             time.Duration
+		next               bool
+		zone               []string
+	)
+
+	for _, w := range strings.Fields(s) {
+		lw := strings.ToLower(w)
+
+		switch {
+		case lw == "now" || lw == "today":
+			days = append(days, 0)
+
+		case lw == "tomorrow":
+			days = append(days, 1)
+
+		case lw == "yesterday":
+			days = append(days, -1)
+
+		case lw == "next":
+			next = true
+
+		case len(lw) >= 3 && isWeekday(lw):
+			weekday = append(weekday, int(weekdays[lw[:3]]))
+
+		case isoDate.MatchString(lw):
+			date = lw
+
+		case clock.MatchString(lw):
+			m := clock.FindStringSubmatch(lw)
+			h, _ := strconv.Atoi(m[1])
+			min, _ := strconv.Atoi(m[2])
+			sec, _ := strconv.Atoi("0" + m[3])
+			if h > 23 || min > 59 || sec > 59 {
+				return time.Time{}, "", fmt.Errorf("bad time of day %q", w)
+			}
+			hms = []int{h, min, sec}
+
+		case (lw[0] == '+' || lw[0] == '-') && len(lw) > 1:
+			d, err := time.ParseDuration(lw)
+			if err != nil {
+				return time.Time{}, "", fmt.Errorf("bad offset %q: %v", w, err)
+			}
+			offset += d
+
+		case isNumber(w):
+			return time.Time{}, "", fmt.Errorf("cannot parse %q: want %s:00 for a time of day or @%s for Unix seconds", s, w, w)
+
+		default:
+			zone = append(zone, w)
+		}
+	}
+
+	if len(zone) > 0 {
+		z, ok := c.FindZone(strings.Join(zone, " "))
+		if !ok {
+			return time.Time{}, "", fmt.Errorf("cannot parse %q: unknown time zone %q", s, strings.Join(zone, " "))
+		}
+		loc = z.Location
+	}
+
+	t = now.In(loc)
+
+	if date != "" {
+		d, err := time.ParseInLocation("2006-01-02", date, loc)
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		t, note = WallClock(loc, d.Year(), d.Month(), d.Day(), t.Hour(), t.Minute(), t.Second())
+	}
+
+	for _, d := range days {
+		t = t.AddDate(0, 0, d)
+	}
+
+	for _, wd := range weekday {
+		d := (wd - int(t.Weekday()) + 7) % 7
+		if next && d == 0 {
+			d = 7
+		}
+		t = t.AddDate(0, 0, d)
+	}
+
+	if hms != nil {
+		t, note = WallClock(loc, t.Year(), t.Month(), t.Day(), hms[0], hms[1], hms[2])
+	}
+
+	return t.Add(offset), note, nil
+}
+
+// isNumber reports whether s is all digits, e.g. "15".
+func isNumber(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
+
+// isWeekday accepts "mon", "monday" and the like.
+func isWeekday(s string) bool {
+	wd, ok := weekdays[s[:3]]
+	return ok && strings.HasPrefix(strings.ToLower(wd.String()), s)
+}
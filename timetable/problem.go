@@ -0,0 +1,80 @@
+package timetable
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Problem is a configuration mistake that was worked around, e.g. a bogus
+// time zone shown as UTC or a malformed hour range left at its default.
+type Problem struct {
+	// Var is the offending setting, e.g. "MTZDATE_GREEN_HOURS".
+	Var string
+
+	// Value is the offending entry, e.g. "8-l7".
+	Value string
+
+	// Msg says what is wrong and, where it isn't obvious, what was done
+	// instead.
+	Msg string
+
+	// Suggestion is a likely fix for Value, if any, e.g. "8-17".
+	Suggestion string
+}
+
+func (p Problem) Error() string {
+	s := fmt.Sprintf("%q: %s", p.Value, p.Msg)
+	if p.Var != "" {
+		s = p.Var + ": " + s
+	}
+	if p.Suggestion != "" {
+		s += fmt.Sprintf("; did you mean %q?", p.Suggestion)
+	}
+	return s
+}
+
+// Problems are returned as an error by the functions that carry on despite
+// them, e.g. LoadEnv.
+type Problems []Problem
+
+func (ps Problems) Error() string {
+	var s []string
+	for _, p := range ps {
+		s = append(s, p.Error())
+	}
+	return strings.Join(s, "; ")
+}
+
+// in attributes the problems to the setting v, keeping the more specific
+// setting of any problem that already has one.
+func (ps Problems) in(v string) Problems {
+	for i := range ps {
+		if ps[i].Var == "" {
+			ps[i].Var = v
+		}
+	}
+	return ps
+}
+
+// err returns ps as an error, or nil if there are none.
+func (ps Problems) err() error {
+	if len(ps) == 0 {
+		return nil
+	}
+	return ps
+}
+
+// asProblems unpacks err as returned by ParseZones, ParseHours and the like,
+// wrapping any other error as a Problem with value v.
+func asProblems(err error, v string) Problems {
+	switch err := err.(type) {
+	case nil:
+		return nil
+	case Problems:
+		return err
+	case Problem:
+		return Problems{err}
+	default:
+		return Problems{{Value: v, Msg: err.Error()}}
+	}
+}
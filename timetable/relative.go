@@ -0,0 +1,34 @@
+package timetable
+
+import (
+	"fmt"
+	"time"
+)
+
+// Relative describes how far ahead of c.Ref zone z is at now, e.g. "+9h",
+// "-5:30" or "same", followed by "+1d" or "-1d" when the dates differ.
+func (c *Config) Relative(z Zone, now time.Time) string {
+	ref := c.Ref
+	if ref == nil {
+		ref = time.Local
+	}
+
+	t, r := now.In(z.Location), now.In(ref)
+	_, tOff := t.Zone()
+	_, rOff := r.Zone()
+
+	s := "same"
+	if tOff != rOff {
+		s = shortOffset(tOff - rOff)
+	}
+
+	// compare calendar dates, not instants
+	td := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	rd := time.Date(r.Year(), r.Month(), r.Day(), 0, 0, 0, 0, time.UTC)
+
+	if days := int(td.Sub(rd).Hours() / 24); days != 0 {
+		s += fmt.Sprintf(" %+dd", days)
+	}
+
+	return s
+}
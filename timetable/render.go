@@ -0,0 +1,103 @@
+package timetable
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Render writes one line per zone in c.Zones to w, showing now in that zone.
+func (c *Config) Render(now time.Time, w io.Writer) error {
+	maxLen, maxRel, flagWidth := 0, 0, c.flagWidth()
+	maxDate, maxDST := 0, 0
+
+	for _, z := range c.Zones {
+		if w := DisplayWidth(c.locale(z).Format(now.In(z.Location), c.layout(z))); w > maxDate {
+			maxDate = w
+		}
+		if DisplayWidth(z.label()) > maxLen {
+			maxLen = DisplayWidth(z.label())
+		}
+		if len(c.Relative(z, now)) > maxRel {
+			maxRel = len(c.Relative(z, now))
+		}
+		if strings.ContainsRune(c.Format, 't') && DisplayWidth(c.DSTNote(z, now)) > maxDST {
+			maxDST = DisplayWidth(c.DSTNote(z, now))
+		}
+	}
+	maxLen++
+
+	for _, z := range c.Zones {
+		// Fri Jul 27 03:32:04 UTC, with the time of day colored by band
+		t, l, layout := now.In(z.Location), c.locale(z), c.layout(z)
+		date := formatTime(t, layout, l, c.Theme.Style(c.Band(z, now))) +
+			strings.Repeat(" ", maxDate-DisplayWidth(l.Format(t, layout)))
+
+		var line strings.Builder
+
+		for _, r := range c.Format {
+			var col, sep string
+
+			switch string(r) {
+			case "d":
+				// datetime
+				col, sep = date, " "
+
+			case "f":
+				// flag
+				col, sep = z.flag(flagWidth), "  "
+
+			case "o":
+				// offset from local or reference time zone
+				col, sep = fmt.Sprintf("%-*s", maxRel, c.Relative(z, now)), " "
+
+			case "t":
+				// upcoming offset change
+				col, sep = pad(c.DSTNote(z, now), maxDST), " "
+
+			case "c":
+				// city/time zone
+				col, sep = pad(z.label(), maxLen-1), " "
+			}
+
+			if r == c.Highlight {
+				// keep highlighting past the resets of colored workhours
+				if c.Theme.Highlight != "" {
+					col = strings.Replace(col, "\x1b[0m", "\x1b[0m"+c.Theme.Highlight.sequence(), -1)
+				}
+				col = c.Theme.Highlight.Sprint(col)
+			}
+
+			line.WriteString(col + sep)
+		}
+
+		if name, ok := c.Holiday(z, now); ok {
+			fmt.Fprintf(&line, "(%s)", name)
+		}
+
+		if _, err := fmt.Fprintln(w, line.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// locale is the Locale of zone z, else that of c; nil means English as
+// time.Format has it.
+func (c *Config) locale(z Zone) *Locale {
+	if z.Locale != nil {
+		return z.Locale
+	}
+	return c.Locale
+}
+
+// layout is the layout of the "d" column for zone z: c.Layout, unless that is
+// the default and the zone's locale orders the date its own way.
+func (c *Config) layout(z Zone) string {
+	if l := c.locale(z); l != nil && c.Layout == DefaultLayout {
+		return l.Layout
+	}
+	return c.Layout
+}
@@ -0,0 +1,62 @@
+package timetable
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// RenderRuler writes one row of 24 hour cells per zone in c.Zones to w, the
+// cells of all rows lined up on the same instants, from midnight in c.Ref to
+// the following midnight. Each cell shows the zone's hour colored by band;
+// the hour of now is marked.
+func (c *Config) RenderRuler(now time.Time, w io.Writer) error {
+	ref := c.Ref
+	if ref == nil {
+		ref = time.Local
+	}
+
+	r := now.In(ref)
+	start := time.Date(r.Year(), r.Month(), r.Day(), 0, 0, 0, 0, ref)
+	current := int(now.Sub(start) / time.Hour)
+
+	maxLen := 0
+	for _, z := range c.Zones {
+		if DisplayWidth(z.label()) > maxLen {
+			maxLen = DisplayWidth(z.label())
+		}
+	}
+
+	// label, space, flag, space
+	flagWidth := c.flagWidth()
+	indent := maxLen + flagWidth + 2
+
+	if _, err := fmt.Fprintf(w, "%*s▼\n", indent+3*current, ""); err != nil {
+		return err
+	}
+
+	for _, z := range c.Zones {
+		var line strings.Builder
+
+		fmt.Fprintf(&line, "%s %s ", pad(z.label(), maxLen), z.flag(flagWidth))
+
+		for i := 0; i < 24; i++ {
+			t := start.Add(time.Duration(i) * time.Hour)
+
+			// sub-hour offsets such as +0545 straddle two local hours
+			cell := c.Theme.Sprint(c.Band(z, t), t.In(z.Location).Format("15"))
+			if i == current {
+				cell = c.Theme.Highlight.Sprint(cell)
+			}
+
+			line.WriteString(cell + " ")
+		}
+
+		if _, err := fmt.Fprintln(w, strings.TrimSuffix(line.String(), " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package timetable
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// templateFuncs are the helpers available to MTZDATE_TEMPLATE, e.g.
+//
+//	{{.Flag}} {{.Label}} {{color .Band (fmt "15:04" .Time)}} ({{offset .Time}})
+var templateFuncs = template.FuncMap{
+	// fmt "15:04" .Time
+	"fmt": func(layout string, t time.Time) string {
+		return t.Format(layout)
+	},
+
+	// color .Band "text", in the theme of the Config, see RenderTemplate
+	"color": Themes[DefaultTheme].Sprint,
+
+	// offset .Time -> "+9h", "+5:45", "-3:30"
+	"offset": func(t time.Time) string {
+		_, sec := t.Zone()
+		return shortOffset(sec)
+	},
+
+	// pad 12 .Label, by display width
+	"pad": func(n int, s string) string {
+		return pad(s, n)
+	},
+
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// RenderTemplate writes one line per zone to w by executing c.Template, a
+// text/template, on the Row of each zone.
+func (c *Config) RenderTemplate(now time.Time, w io.Writer) error {
+	tmpl, err := template.New("MTZDATE_TEMPLATE").
+		Funcs(templateFuncs).
+		Funcs(template.FuncMap{"color": c.Theme.Sprint}).
+		Parse(c.Template)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range c.Rows(now) {
+		if err := tmpl.Execute(w, r); err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shortOffset formats an offset in seconds as "+9h" or "+5:45".
+func shortOffset(sec int) string {
+	sign := "+"
+	if sec < 0 {
+		sign, sec = "-", -sec
+	}
+
+	h, m := sec/3600, sec%3600/60
+	if m == 0 {
+		return fmt.Sprintf("%s%dh", sign, h)
+	}
+	return fmt.Sprintf("%s%d:%02d", sign, h, m)
+}
@@ -0,0 +1,85 @@
+package timetable
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Row is the machine-readable form of a line of the time table.
+type Row struct {
+	Label   string    `json:"label"`
+	Name    string    `json:"zone"`
+	Flag    string    `json:"flag"`
+	Country string    `json:"country"`
+	Abbrev  string    `json:"abbreviation"`
+	Offset  string    `json:"offset"`
+	Time    time.Time `json:"time"`
+	Workday bool      `json:"workday"`
+	Band    Band      `json:"band"`
+	Holiday string    `json:"holiday"`
+}
+
+// Rows returns one Row per zone in c.Zones, showing now in that zone.
+func (c *Config) Rows(now time.Time) []Row {
+	var rows []Row
+
+	for _, z := range c.Zones {
+		t := now.In(z.Location).Truncate(time.Second)
+		abbrev, _ := t.Zone()
+		holiday, _ := c.Holiday(z, t)
+
+		rows = append(rows, Row{
+			Label:   z.Label,
+			Name:    z.Name,
+			Flag:    z.Flag,
+			Country: z.Country,
+			Abbrev:  abbrev,
+			Offset:  t.Format("-07:00"),
+			Time:    t,
+			Workday: c.Workday(z, t),
+			Band:    c.Band(z, t),
+			Holiday: holiday,
+		})
+	}
+
+	return rows
+}
+
+// RenderJSON writes c.Rows(now) to w as a JSON array.
+func (c *Config) RenderJSON(now time.Time, w io.Writer) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+
+	return e.Encode(c.Rows(now))
+}
+
+// RenderCSV writes c.Rows(now) to w as comma-separated values with a header,
+// or as tab-separated values when comma is '\t'.
+func (c *Config) RenderCSV(now time.Time, w io.Writer, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	records := [][]string{{
+		"label", "zone", "flag", "country", "abbreviation", "offset", "time", "workday", "band", "holiday",
+	}}
+
+	for _, r := range c.Rows(now) {
+		records = append(records, []string{
+			r.Label,
+			r.Name,
+			r.Flag,
+			r.Country,
+			r.Abbrev,
+			r.Offset,
+			r.Time.Format(time.RFC3339),
+			strconv.FormatBool(r.Workday),
+			r.Band.String(),
+			r.Holiday,
+		})
+	}
+
+	return cw.WriteAll(records)
+}
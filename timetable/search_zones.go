@@ -0,0 +1,110 @@
+package timetable
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// SearchZones returns the time zones in ZoneNames that match query, best
+// matches first. Zone names, city names ("new york"), abbreviations in effect
+// at now ("JST"), country codes and country names are all matched, exactly,
+// by prefix and by substring; zone and city names also as a subsequence
+// ("lsa" for America/Los_Angeles). An empty query matches every zone.
+func SearchZones(query string, now time.Time) []Zone {
+	type match struct {
+		zone  Zone
+		score int
+	}
+
+	var matches []match
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	countries := zoneCountries()
+
+	for _, name := range ZoneNames() {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+
+		abbrev, _ := now.In(loc).Zone()
+		city := dirPrefix.ReplaceAllString(name, "")
+		cc := countries[name]
+
+		score := 0
+		for _, key := range []struct {
+			s     string
+			loose bool
+		}{
+			{name, true},
+			{city, true},
+			{strings.Replace(city, "_", " ", -1), true},
+			{abbrev, false},
+			{cc, false},
+			{countryCode[cc], false},
+		} {
+			if s := matchScore(query, strings.ToLower(key.s), key.loose); s > score {
+				score = s
+			}
+		}
+
+		if score == 0 {
+			continue
+		}
+
+		matches = append(matches, match{
+			zone: Zone{
+				Label:    city,
+				Name:     name,
+				Flag:     Flag(cc),
+				Country:  cc,
+				Location: loc,
+			},
+			score: score,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	zones := make([]Zone, len(matches))
+	for i, m := range matches {
+		zones[i] = m.zone
+	}
+
+	return zones
+}
+
+// matchScore ranks how well query matches key, 0 meaning not at all. Only a
+// loose match may be a subsequence of key.
+func matchScore(query, key string, loose bool) int {
+	switch {
+	case key == "":
+		return 0
+	case query == "" || key == query:
+		return 4
+	case strings.HasPrefix(key, query):
+		return 3
+	case strings.Contains(key, query):
+		return 2
+	}
+
+	if !loose {
+		return 0
+	}
+
+	// subsequence
+	q, i := []rune(query), 0
+	for _, r := range key {
+		if i < len(q) && q[i] == r {
+			i++
+		}
+	}
+	if i == len(q) && len(q) >= 3 {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,166 @@
+package timetable
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Flag returns the emoji flag for a country name, an ISO 3166-1 alpha-2
+// code or an ISO 3166-2 subdivision code, or for one of the other flags named
+// on Emojipedia, e.g. "Chequered", or "" if there is none. Country flags are
+// spelled in regional indicator symbols, e.g. "JP" -> 🇯🇵. England, Scotland
+// and Wales, the only subdivisions with a recommended emoji flag, are spelled
+// in tag characters after a black flag, e.g. "GB-SCT"; other subdivisions get
+// their country's flag, e.g. "US-CA" -> 🇺🇸.
+//
+// https://emojipedia.org/flags/
+// https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+// https://en.wikipedia.org/wiki/Regional_indicator_symbol
+// https://en.wikipedia.org/wiki/Tags_(Unicode_block)
+func Flag(s string) string {
+	if badge, ok := badges[s]; ok {
+		return badge
+	}
+	if code, ok := aliases[s]; ok {
+		s = code
+	}
+
+	cc := Country(s)
+	if cc == "" {
+		return ""
+	}
+
+	// GB-SCT -> 🏴 g b s c t cancel
+	if subdivisionFlags[s] {
+		sd := strings.TrimPrefix(s, cc+"-")
+		var b strings.Builder
+		b.WriteRune(blackFlag)
+		for _, r := range strings.ToLower(cc + sd) {
+			b.WriteRune(tagBase + r)
+		}
+		b.WriteRune(cancelTag)
+		return b.String()
+	}
+
+	// JP -> J P
+	var b strings.Builder
+	for _, r := range cc {
+		b.WriteRune(regionalIndicatorA + r - 'A')
+	}
+	return b.String()
+}
+
+const (
+	blackFlag = '\U0001F3F4'
+	tagBase   = '\U000E0000'
+	cancelTag = '\U000E007F'
+)
+
+// subdivisionFlags are the subdivisions whose tag sequences are RGI emoji,
+// i.e. recommended for general interchange; fonts draw no others.
+//
+// https://unicode.org/reports/tr51/#flag-emoji-tag-sequences
+var subdivisionFlags = map[string]bool{"GB-ENG": true, "GB-SCT": true, "GB-WLS": true}
+
+// subdivisionCode matches ISO 3166-2 codes, e.g. "US-CA" or "GB-SCT".
+var subdivisionCode = regexp.MustCompile(`^[A-Z]{2}-[A-Z0-9]{1,3}$`)
+
+// codeLike matches what is meant as a country or subdivision code, e.g. "JQ"
+// or "XX-YYY", rather than as a badge.
+var codeLike = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,3})?$`)
+
+// Country returns the two-letter country code for a country name, a code or
+// a subdivision code, e.g. "GB" for "United Kingdom", "GB" or "GB-SCT", or ""
+// if there is none.
+func Country(s string) string {
+	if code, ok := aliases[s]; ok {
+		s = code
+	}
+	if subdivisionCode.MatchString(s) {
+		s = s[:2]
+	}
+
+	if countryCode[s] != "" {
+		return s
+	}
+
+	for cc, name := range countryCode {
+		if name == s {
+			return cc
+		}
+	}
+
+	return ""
+}
+
+// CountryName returns the name of the country with the two-letter code cc.
+func CountryName(cc string) string {
+	return countryCode[cc]
+}
+
+// SetFlags assigns flags, and with them holiday calendars, to zones from a comma-separated map of city names or
+// aliases followed by a country, country or subdivision code or flag name, e.g.
+// "Chicago:US,Paris:France,Edinburgh:GB-SCT". Anything else that does not look
+// like a code is shown as it is, e.g. "Office:🏢" or "Home:hq". Malformed
+// entries, unknown codes, likely misspelled countries and labels that match no
+// zone are reported in the returned Problems.
+func (c *Config) SetFlags(s string) error {
+	var problems Problems
+
+	for _, kv := range strings.Split(s, ",") {
+		_kv := strings.Split(kv, ":")
+		if len(_kv) == 1 {
+			if kv != "" {
+				problems = append(problems, Problem{Value: kv, Msg: "want LABEL:COUNTRY"})
+			}
+			continue
+		}
+
+		flag, known := Flag(_kv[1]), true
+		if flag == "" {
+			var names []string
+			for cc, name := range countryCode {
+				names = append(names, cc, name)
+			}
+			sort.Strings(names)
+
+			if suggestion := suggest(_kv[1], names); suggestion != "" || codeLike.MatchString(_kv[1]) {
+				problems = append(problems, Problem{
+					Value:      _kv[1],
+					Msg:        "unknown country or country code, keeping the zone's flag",
+					Suggestion: suggestion,
+				})
+				known = false
+			} else {
+				// a badge of the user's own
+				flag = _kv[1]
+			}
+		}
+
+		var labels []string
+		found := false
+		for i := range c.Zones {
+			labels = append(labels, c.Zones[i].Label)
+			if c.Zones[i].Label == _kv[0] && known {
+				c.Zones[i].Flag = flag
+				if cc := Country(_kv[1]); cc != "" {
+					c.Zones[i].Country = cc
+				}
+			}
+			if c.Zones[i].Label == _kv[0] {
+				found = true
+			}
+		}
+
+		if !found {
+			problems = append(problems, Problem{
+				Value:      _kv[0],
+				Msg:        "no time zone has this label",
+				Suggestion: suggest(_kv[0], labels),
+			})
+		}
+	}
+
+	return problems.err()
+}
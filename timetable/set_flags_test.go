@@ -0,0 +1,60 @@
+package timetable
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFlag(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"JP", "🇯🇵"},
+		{"France", "🇫🇷"},
+		{"GB-SCT", "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"},
+		{"Wales", "🏴\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F"},
+		{"England", "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"},
+		{"US-CA", "🇺🇸"},
+		{"GB-NIR", "🇬🇧"},
+		{"FR-75", "🇫🇷"},
+		{"Chequered", "🏁"},
+		{"XX", ""},
+		{"XX-YYY", ""},
+	}
+	for _, tt := range tests {
+		if got := Flag(tt.in); got != tt.want {
+			t.Errorf("Flag(%q) = %+q, want %+q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSetFlags(t *testing.T) {
+	tests := []struct {
+		flags, want string
+		bad         []string
+	}{
+		{flags: "Home:JP", want: "🇯🇵"},
+		{flags: "Home:Japan", want: "🇯🇵"},
+		{flags: "Home:🏢", want: "🏢"},
+		{flags: "Home:hq", want: "hq"},
+		{flags: "Home:JQ", want: "☁️", bad: []string{`"JQ": unknown country or country code, keeping the zone's flag`}},
+		{flags: "Home:XX-YYY", want: "☁️", bad: []string{`"XX-YYY": unknown country or country code, keeping the zone's flag`}},
+		{flags: "Home:Japn", want: "☁️", bad: []string{`"Japn": unknown country or country code, keeping the zone's flag; did you mean "Japan"?`}},
+	}
+	for _, tt := range tests {
+		c := New()
+		c.Zones, _ = ParseZones("Home:UTC")
+
+		var bad []string
+		for _, p := range asProblems(c.SetFlags(tt.flags), "") {
+			bad = append(bad, p.Error())
+		}
+
+		if got := c.Zones[0].Flag; got != tt.want {
+			t.Errorf("SetFlags(%q): flag %q, want %q", tt.flags, got, tt.want)
+		}
+		if strings.Join(bad, "\n") != strings.Join(tt.bad, "\n") {
+			t.Errorf("SetFlags(%q): problems %q, want %q", tt.flags, bad, tt.bad)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package timetable
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// suggest returns the candidate closest to s by edit distance, ignoring case,
+// if it is close enough to be a likely typo, e.g. "Europe/Berln" ->
+// "Europe/Berlin"; otherwise "". Ties go to the candidate sharing the longer
+// prefix with s, e.g. "USA" -> "US" rather than "SA".
+func suggest(s string, candidates []string) string {
+	s = strings.ToLower(s)
+	best, bestDistance, bestPrefix := "", utf8.RuneCountInString(s)/3+1, 0
+
+	for _, c := range candidates {
+		lc := strings.ToLower(c)
+		d, prefix := editDistance(s, lc), commonPrefixLen(s, lc)
+		if d < bestDistance || d == bestDistance && best != "" && prefix > bestPrefix {
+			best, bestDistance, bestPrefix = c, d, prefix
+		}
+	}
+
+	return best
+}
+
+// commonPrefixLen is the number of leading bytes a and b share.
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// editDistance is the Levenshtein distance between a and b in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			next := prev + cost
+			if row[j]+1 < next {
+				next = row[j] + 1
+			}
+			if row[j-1]+1 < next {
+				next = row[j-1] + 1
+			}
+
+			prev, row[j] = row[j], next
+		}
+	}
+
+	return row[len(rb)]
+}
@@ -0,0 +1,205 @@
+package timetable
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Style is a sequence of SGR parameters, e.g. "1;38;5;214" for bold orange;
+// "" shows text as is. See ParseStyle.
+type Style string
+
+// Sprint wraps s in the escape sequences of style, unless colors are off,
+// see color.NoColor.
+func (style Style) Sprint(s string) string {
+	if style == "" || color.NoColor {
+		return s
+	}
+	return style.sequence() + s + "\x1b[0m"
+}
+
+// sequence is the escape sequence that turns style on.
+func (style Style) sequence() string {
+	return "\x1b[" + string(style) + "m"
+}
+
+// Theme styles the bands and the highlighted column.
+type Theme struct {
+	Green, Yellow, Faint, Highlight Style
+}
+
+// Style returns the style of band b.
+func (t Theme) Style(b Band) Style {
+	switch b {
+	case Green:
+		return t.Green
+	case Yellow:
+		return t.Yellow
+	case Faint:
+		return t.Faint
+	}
+	return ""
+}
+
+// Sprint styles s as band b.
+func (t Theme) Sprint(b Band, s string) string {
+	return t.Style(b).Sprint(s)
+}
+
+// DefaultTheme is the theme of New.
+const DefaultTheme = "default"
+
+// Themes are the named themes of MTZDATE_THEME.
+var Themes = map[string]Theme{
+	// the 16 colors of the terminal's own palette
+	"default": {Green: "32", Yellow: "33", Faint: "2", Highlight: "7"},
+
+	// darker shades that stay legible on a white background
+	"light": {Green: "38;5;28", Yellow: "38;5;130", Faint: "38;5;246", Highlight: "7"},
+
+	// bold black on bright backgrounds
+	"high-contrast": {Green: "1;30;102", Yellow: "1;30;103", Faint: "97;100", Highlight: "1;7"},
+
+	// blue and orange from the Okabe-Ito palette, which most forms of color
+	// blindness tell apart
+	"colorblind-safe": {Green: "38;5;33", Yellow: "38;5;214", Faint: "2", Highlight: "7"},
+}
+
+// themeNames lists Themes, as candidates for suggest.
+func themeNames() []string {
+	var names []string
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+var (
+	// colorNames are the eight colors of the terminal's palette, in SGR
+	// order; "bright-" picks their bright variants.
+	colorNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+	attributes = map[string]string{
+		"bold":      "1",
+		"faint":     "2",
+		"dim":       "2",
+		"italic":    "3",
+		"underline": "4",
+		"blink":     "5",
+		"reverse":   "7",
+		"strike":    "9",
+	}
+)
+
+// ParseStyle reads a style as words separated by spaces or commas: attributes
+// (bold, faint or dim, italic, underline, blink, reverse, strike), a
+// foreground color and "bg=" a background color, e.g.
+//
+//	"bold fg=bright-white bg=#2e7d32"
+//
+// A color is one of the terminal's 16, e.g. "green" or "bright-green" ("gray"
+// is bright-black), a number from 0 to 255 of its 256-color palette, or
+// "#rrggbb" or "#rgb" in 24-bit color. "none" is no style at all. All unknown
+// words are reported in the returned Problems.
+func ParseStyle(s string) (Style, error) {
+	var params []string
+	var problems Problems
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ' ' || r == ','
+	}) {
+		if word == "none" {
+			continue
+		}
+
+		if attr, ok := attributes[word]; ok {
+			params = append(params, attr)
+			continue
+		}
+
+		// 30-37 and 38;... are foreground, 40-47 and 48;... background
+		base, prefix := 30, ""
+		switch {
+		case strings.HasPrefix(word, "fg="):
+			prefix = "fg="
+		case strings.HasPrefix(word, "bg="):
+			base, prefix = 40, "bg="
+		}
+
+		value := strings.TrimPrefix(word, prefix)
+		param, err := colorParam(value, base)
+		if err != nil {
+			p := Problem{Value: word, Msg: err.Error()}
+			if suggestion := suggest(value, styleWords()); suggestion != "" {
+				p.Suggestion = prefix + suggestion
+			}
+			problems = append(problems, p)
+			continue
+		}
+		params = append(params, param)
+	}
+
+	return Style(strings.Join(params, ";")), problems.err()
+}
+
+// colorParam returns the SGR parameters of color s as a foreground (base 30)
+// or background (base 40) color.
+func colorParam(s string, base int) (string, error) {
+	switch {
+	case strings.HasPrefix(s, "#"):
+		hex := s[1:]
+		if len(hex) == 3 {
+			// #f80 -> #ff8800
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+
+		rgb, err := strconv.ParseUint(hex, 16, 32)
+		if len(hex) != 6 || err != nil {
+			return "", fmt.Errorf("bad 24-bit color, want #rrggbb or #rgb")
+		}
+		return fmt.Sprintf("%d;2;%d;%d;%d", base+8, rgb>>16, rgb>>8&0xff, rgb&0xff), nil
+
+	case s != "" && s[0] >= '0' && s[0] <= '9':
+		n, err := strconv.Atoi(s)
+		if err != nil || n > 255 {
+			return "", fmt.Errorf("bad 256-color number, want 0 to 255")
+		}
+		return fmt.Sprintf("%d;5;%d", base+8, n), nil
+
+	case s == "gray", s == "grey":
+		return strconv.Itoa(base + 60), nil
+	}
+
+	name := strings.TrimPrefix(s, "bright-")
+	for i, c := range colorNames {
+		if name == c {
+			if name != s {
+				i += 60
+			}
+			return strconv.Itoa(base + i), nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown color or attribute")
+}
+
+// styleWords lists the color names and attributes, as candidates for
+// suggest.
+func styleWords() []string {
+	words := []string{"gray", "grey", "none"}
+	for _, c := range colorNames {
+		words = append(words, c, "bright-"+c)
+	}
+	for attr := range attributes {
+		words = append(words, attr)
+	}
+	sort.Strings(words)
+
+	return words
+}
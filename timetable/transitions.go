@@ -0,0 +1,121 @@
+package timetable
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultDSTDays is how far ahead the "t" column looks for offset changes.
+const DefaultDSTDays = 7
+
+// Transition is a change of a zone's UTC offset, e.g. the end of summer time.
+type Transition struct {
+	Zone Zone
+
+	// At is the first instant at the new offset, in the zone.
+	At time.Time
+
+	// Before and After are the abbreviations on either side, e.g. "CEST"
+	// and "CET".
+	Before, After string
+
+	// Delta is the change of UTC offset, e.g. -1h.
+	Delta time.Duration
+}
+
+// NextTransition returns the first change of z's UTC offset after from and
+// no later than to. time.Location keeps its transitions to itself, so they
+// are found by scanning a day at a time and bisecting to the second.
+func NextTransition(z Zone, from, to time.Time) (Transition, bool) {
+	before, off := from.In(z.Location).Zone()
+
+	for t := from; t.Before(to); {
+		next := t.Add(24 * time.Hour)
+		if next.After(to) {
+			next = to
+		}
+
+		if _, o := next.In(z.Location).Zone(); o == off {
+			t = next
+			continue
+		}
+
+		// offset(lo) is the old one, offset(hi) the new one
+		lo, hi := t.Unix(), next.Unix()
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if _, o := time.Unix(mid, 0).In(z.Location).Zone(); o == off {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+
+		at := time.Unix(hi, 0).In(z.Location)
+		after, o := at.Zone()
+
+		return Transition{
+			Zone:   z,
+			At:     at,
+			Before: before,
+			After:  after,
+			Delta:  time.Duration(o-off) * time.Second,
+		}, true
+	}
+
+	return Transition{}, false
+}
+
+// Transitions lists every change of UTC offset in c.Zones after from and no
+// later than to, earliest first. Zones sharing a time zone are listed once.
+func (c *Config) Transitions(from, to time.Time) []Transition {
+	var transitions []Transition
+	seen := make(map[string]bool)
+
+	for _, z := range c.Zones {
+		if seen[z.Name] {
+			continue
+		}
+		seen[z.Name] = true
+
+		for t := from; ; {
+			tr, ok := NextTransition(z, t, to)
+			if !ok {
+				break
+			}
+			transitions = append(transitions, tr)
+			t = tr.At
+		}
+	}
+
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].At.Before(transitions[j].At)
+	})
+
+	return transitions
+}
+
+// DSTNote announces z's next change of UTC offset if it comes within
+// c.DSTDays of now, e.g. "DST→ in 3d, -1h", or returns "".
+func (c *Config) DSTNote(z Zone, now time.Time) string {
+	tr, ok := NextTransition(z, now, now.AddDate(0, 0, c.DSTDays))
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("DST→ in %s, %s", Countdown(tr.At.Sub(now)), shortOffset(int(tr.Delta/time.Second)))
+}
+
+// Countdown rounds d down to whole days, hours or minutes, e.g. "3d", "5h" or
+// "40m".
+func Countdown(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	default:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+}
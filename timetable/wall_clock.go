@@ -0,0 +1,58 @@
+package timetable
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WallClock returns the instant the clocks in loc show the given date and
+// time of day. Around a change of UTC offset, a wall-clock time may occur
+// twice, in which case the earlier instant is taken, or not at all, in which
+// case it is moved forward by the gap, as clocks were; note then says which
+// interpretation was chosen, and is "" otherwise.
+func WallClock(loc *time.Location, year int, month time.Month, day, hour, min, sec int) (t time.Time, note string) {
+	// the wall clock read as if it were UTC
+	naive := time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+
+	const layout = "2006-01-02 15:04:05"
+	wall := naive.Format(layout)
+
+	// try the offsets in effect around it
+	var found []time.Time
+	var offsets []int
+	for _, probe := range []time.Time{naive.Add(-24 * time.Hour), naive, naive.Add(24 * time.Hour)} {
+		_, off := probe.In(loc).Zone()
+		offsets = append(offsets, off)
+
+		t := naive.Add(-time.Duration(off) * time.Second).In(loc)
+		if t.Format(layout) != wall {
+			continue
+		}
+		if len(found) == 0 || !found[len(found)-1].Equal(t) && !found[0].Equal(t) {
+			found = append(found, t)
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].Before(found[j]) })
+
+	wall = strings.TrimSuffix(wall, ":00")
+
+	switch {
+	case len(found) == 1:
+		return found[0], ""
+
+	case len(found) > 1:
+		return found[0], fmt.Sprintf("%s occurs twice in %s, at %s and at %s; using the first",
+			wall, loc, found[0].Format("15:04 MST"), found[1].Format("15:04 MST"))
+	}
+
+	// skipped: read it with the offset from before the gap, which lands as
+	// far past the gap as the wall clock is into it
+	t = naive.Add(-time.Duration(offsets[0]) * time.Second).In(loc)
+	_, after := t.Zone()
+
+	return t, fmt.Sprintf("%s does not exist in %s, whose clocks skip ahead by %s; using %s",
+		wall, loc, strings.TrimPrefix(shortOffset(after-offsets[0]), "+"), t.Format("15:04 MST"))
+}
@@ -0,0 +1,101 @@
+package timetable
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWallClock(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     string
+		wall     string
+		want     string
+		wantNote string
+	}{
+		{
+			name: "ordinary",
+			zone: "Europe/Berlin",
+			wall: "2026-11-03 09:30",
+			want: "2026-11-03T09:30:00+01:00",
+		},
+		{
+			name:     "skipped",
+			zone:     "Europe/Berlin",
+			wall:     "2027-03-28 02:30",
+			want:     "2027-03-28T03:30:00+02:00",
+			wantNote: "2027-03-28 02:30 does not exist in Europe/Berlin, whose clocks skip ahead by 1h; using 03:30 CEST",
+		},
+		{
+			name:     "repeated",
+			zone:     "Europe/Berlin",
+			wall:     "2026-10-25 02:30",
+			want:     "2026-10-25T02:30:00+02:00",
+			wantNote: "2026-10-25 02:30 occurs twice in Europe/Berlin, at 02:30 CEST and at 02:30 CET; using the first",
+		},
+		{
+			name:     "skipped half hour",
+			zone:     "Australia/Lord_Howe",
+			wall:     "2026-10-04 02:15",
+			want:     "2026-10-04T02:45:00+11:00",
+			wantNote: "skip ahead by 0:30",
+		},
+		{
+			name:     "repeated half hour",
+			zone:     "Australia/Lord_Howe",
+			wall:     "2026-04-05 01:45",
+			want:     "2026-04-05T01:45:00+11:00",
+			wantNote: "occurs twice",
+		},
+		{
+			name: "after the half hour",
+			zone: "Australia/Lord_Howe",
+			wall: "2026-04-05 02:00",
+			want: "2026-04-05T02:00:00+10:30",
+		},
+		{
+			name:     "skipped midnight",
+			zone:     "America/Santiago",
+			wall:     "2026-09-06 00:30",
+			want:     "2026-09-06T01:30:00-03:00",
+			wantNote: "skip ahead by 1h",
+		},
+		{
+			name: "after the skipped midnight",
+			zone: "America/Santiago",
+			wall: "2026-09-06 01:00",
+			want: "2026-09-06T01:00:00-03:00",
+		},
+		{
+			name:     "repeated before midnight",
+			zone:     "America/Santiago",
+			wall:     "2026-04-04 23:30",
+			want:     "2026-04-04T23:30:00-03:00",
+			wantNote: "occurs twice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Skip(err)
+			}
+			w, err := time.Parse("2006-01-02 15:04", tt.wall)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, note := WallClock(loc, w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second())
+			if got.Format(time.RFC3339) != tt.want {
+				t.Errorf("got %s, want %s", got.Format(time.RFC3339), tt.want)
+			}
+			if got.Location() != loc {
+				t.Errorf("got location %s, want %s", got.Location(), loc)
+			}
+			if tt.wantNote == "" && note != "" || !strings.Contains(note, tt.wantNote) {
+				t.Errorf("got note %q, want %q", note, tt.wantNote)
+			}
+		})
+	}
+}
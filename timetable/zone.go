@@ -0,0 +1,188 @@
+package timetable
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Zone is a single row of the time table.
+type Zone struct {
+	// Label is the desired city name or alias, e.g. "München".
+	Label string
+
+	// Name is the IANA time zone, e.g. "Europe/Berlin".
+	Name string
+
+	// Flag is the emoji shown in the "f" column.
+	Flag string
+
+	// Country is the ISO 3166-1 alpha-2 code whose holidays apply, if known.
+	Country string
+
+	Location *time.Location
+
+	// Workdays, GreenHours and YellowHours override those of the Config for
+	// this zone when not nil.
+	Workdays    map[string]bool
+	GreenHours  [][]int
+	YellowHours [][]int
+
+	// Locale overrides that of the Config for this zone when not nil.
+	Locale *Locale
+
+	// Rivals are the other places a city name resolved by ResolveCity may
+	// refer to, e.g. Portland, Maine for "Portland".
+	Rivals []City
+}
+
+var dirPrefix = regexp.MustCompile(".*/")
+
+// localeTag is shaped like a language tag, e.g. "ja" or "pt-BR".
+var localeTag = regexp.MustCompile(`^[a-z]{2,3}([-_][A-Za-z]{2,4})?$`)
+
+// https://en.wikipedia.org/wiki/List_of_tz_database_time_zones
+/*
+  ParseZones splits a comma-separated list of time zones, each optionally
+  prefaced with a city name or alias and a colon:
+
+    "San Francisco:America/Los_Angeles,UTC,Europe/Paris"
+
+  and optionally followed by the zone's own workdays and green hours, see
+  ParseSchedule:
+
+    "Tel Aviv:Asia/Jerusalem@Sun-Thu/9-18,Bangalore:Asia/Kolkata@/10-19"
+
+  and by the locale of its "d" column, see LookupLocale, before or after the
+  schedule:
+
+    "東京:Asia/Tokyo@ja,München:Europe/Berlin@de@Mon-Fri/9-17"
+
+  Each zone's flag and country come from its label if that names a country,
+  else from the zone's entry in zone.tab, see ZoneCountry. A time zone that
+  is not an IANA name is looked up as a city, see ResolveCity, which also
+  supplies its flag:
+
+    "Paris,Bangalore,Austin"
+
+  Bogus time zones fall back to UTC, and bogus schedules to the global
+  workdays and hours; both are reported in the returned Problems.
+*/
+func ParseZones(s string) ([]Zone, error) {
+	var zones []Zone
+	var problems Problems
+
+	for _, kv := range strings.Split(s, ",") {
+		var z Zone
+		var schedule string
+
+		// Asia/Tokyo@ja@Mon-Fri/9-18
+		suffixes := strings.Split(kv, "@")
+		kv = suffixes[0]
+		for _, suffix := range suffixes[1:] {
+			if l, ok := LookupLocale(suffix); ok {
+				z.Locale = l
+			} else if looksLikeLocale(suffix) {
+				problems = append(problems, Problem{
+					Value:      suffix,
+					Msg:        "unknown locale, want one of " + strings.Join(localeCodes(), ", "),
+					Suggestion: suggest(suffix, localeCodes()),
+				})
+			} else {
+				schedule = suffix
+			}
+		}
+
+		// unpack
+		tz := strings.Split(kv, ":")
+
+		// Missing tz[1] means tz[0] is actual time zone
+		if len(tz) == 1 {
+			tz = append(tz, tz[0])
+		}
+
+		var city City
+		loc, err := time.LoadLocation(tz[1])
+		if err != nil {
+			var ok bool
+			if city, z.Rivals, ok = ResolveCity(tz[1]); ok {
+				tz[1] = city.Zone
+				loc, err = time.LoadLocation(tz[1])
+			}
+		}
+		if err != nil {
+			problems = append(problems, Problem{
+				Value:      tz[1],
+				Msg:        "unknown time zone or city, showing UTC",
+				Suggestion: suggest(tz[1], zoneAndCityNames()),
+			})
+
+			// Fall back to UTC on bogus time zone
+			tz[1] = UTC
+			loc = time.UTC
+		}
+
+		z.Label = dirPrefix.ReplaceAllString(tz[0], "")
+		z.Name = tz[1]
+		z.Location = loc
+
+		// Check labels, cities and time zones for countries and country codes
+		z.Flag, z.Country = Flag(z.Label), Country(z.Label)
+		if z.Flag == "" && city.Country != "" {
+			z.Flag, z.Country = Flag(city.Country), city.Country
+		}
+		if z.Flag == "" {
+			z.Flag = Flag(z.Name)
+		}
+		if cc := ZoneCountry(z.Name); z.Flag == "" && cc != "" {
+			z.Flag, z.Country = Flag(cc), cc
+		}
+
+		if schedule != "" {
+			if err := z.ParseSchedule(schedule); err != nil {
+				for _, p := range asProblems(err, schedule) {
+					p.Msg += "; " + z.Label + " keeps the global workdays and hours"
+					problems = append(problems, p)
+				}
+				z.Workdays, z.GreenHours, z.YellowHours = nil, nil, nil
+			}
+		}
+
+		zones = append(zones, z)
+	}
+
+	return zones, problems.err()
+}
+
+// looksLikeLocale tells a mistyped language tag, e.g. "jp", from a schedule
+// such as "Sun-Thu".
+func looksLikeLocale(s string) bool {
+	_, weekday := weekdays[strings.ToLower(strings.SplitN(s, "-", 2)[0])]
+	return localeTag.MatchString(s) && !weekday
+}
+
+// label hides the redundant "UTC" in the "c" column.
+func (z Zone) label() string {
+	if z.Label == UTC {
+		return ""
+	}
+	return z.Label
+}
+
+// flag pads the flag, badge or its absence to width columns so the columns
+// after it still line up.
+func (z Zone) flag(width int) string {
+	return pad(z.Flag, width)
+}
+
+// flagWidth is the width of the widest flag or badge in c.Zones, and at least
+// the two columns of an emoji flag.
+func (c *Config) flagWidth() int {
+	width := 2
+	for _, z := range c.Zones {
+		if w := DisplayWidth(z.Flag); w > width {
+			width = w
+		}
+	}
+	return width
+}
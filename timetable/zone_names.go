@@ -0,0 +1,222 @@
+package timetable
+
+import (
+	"archive/zip"
+	"bufio"
+	_ "embed" // zone.tab
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// zoneinfoSources are the places tzdata is looked up in, in order: $ZONEINFO
+// (a directory or zip file, as for time.LoadLocation) and the system tzdata.
+// Failing those, time.LoadLocation falls back to the copy compiled in with
+// time/tzdata, whose names are bundled as bundledZoneNames.
+func zoneinfoSources() []string {
+	var sources []string
+
+	if z := os.Getenv("ZONEINFO"); z != "" {
+		sources = append(sources, z)
+	}
+
+	return append(sources,
+		"/usr/share/zoneinfo",
+		"/usr/share/lib/zoneinfo",
+		"/usr/lib/locale/TZ",
+		"/etc/zoneinfo",
+	)
+}
+
+// TZDataSource returns the first tzdata source time.LoadLocation would read,
+// see zoneinfoSources, and the tzdata release it holds, e.g. "2025b", if it
+// says. source is "" when there is none on disk, leaving the copy compiled
+// in with time/tzdata, if any.
+func TZDataSource() (source, version string) {
+	for _, src := range zoneinfoSources() {
+		fi, err := os.Stat(src)
+		if err != nil {
+			continue
+		}
+
+		if !fi.IsDir() {
+			// a zip file such as Go's zoneinfo.zip is named after no release
+			return src, ""
+		}
+		if _, err := os.Stat(filepath.Join(src, UTC)); err != nil {
+			continue
+		}
+
+		// "# version 2025b" heads tzdata.zi; some distributions ship +VERSION
+		if f, err := os.Open(filepath.Join(src, "tzdata.zi")); err == nil {
+			s := bufio.NewScanner(f)
+			if s.Scan() && strings.HasPrefix(s.Text(), "# version ") {
+				version = strings.TrimPrefix(s.Text(), "# version ")
+			}
+			f.Close() // nolint: errcheck
+		}
+		if b, err := os.ReadFile(filepath.Join(src, "+VERSION")); version == "" && err == nil {
+			version = strings.TrimSpace(string(b))
+		}
+
+		return src, version
+	}
+
+	return "", ""
+}
+
+// The IANA time zone names of Go's time/tzdata, one per line.
+//
+//go:embed zoneinfo/zones.txt
+var bundledZoneNames string
+
+// ZoneNames lists the IANA time zones found in any tzdata source, sorted, or
+// else those of time/tzdata.
+func ZoneNames() []string {
+	seen := make(map[string]bool)
+
+	add := func(name string) {
+		// skip the posix/ and right/ copies, zone.tab and the like
+		if name == "" || name[0] < 'A' || name[0] > 'Z' ||
+			strings.ContainsAny(name, ".") ||
+			name == "Factory" || name == "posixrules" || name == "localtime" {
+			return
+		}
+		seen[name] = true
+	}
+
+	for _, src := range zoneinfoSources() {
+		if strings.HasSuffix(src, ".zip") {
+			r, err := zip.OpenReader(src)
+			if err != nil {
+				continue
+			}
+			for _, f := range r.File {
+				if !strings.HasSuffix(f.Name, "/") {
+					add(f.Name)
+				}
+			}
+			r.Close() // nolint: errcheck
+			continue
+		}
+
+		_ = filepath.Walk(src, func(path string, fi os.FileInfo, err error) error { // nolint: errcheck
+			if err != nil {
+				return nil
+			}
+
+			name, _ := filepath.Rel(src, path)
+			name = filepath.ToSlash(name)
+
+			if fi.IsDir() {
+				if name == "posix" || name == "right" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			add(name)
+			return nil
+		})
+	}
+
+	if len(seen) == 0 {
+		for _, name := range strings.Split(bundledZoneNames, "\n") {
+			if !strings.HasPrefix(name, "#") {
+				add(name)
+			}
+		}
+	}
+
+	var names []string
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// A copy of tzdata's zone.tab, for systems without tzdata.
+//
+//go:embed zoneinfo/zone.tab
+var bundledZoneTab string
+
+var (
+	zoneCountriesOnce sync.Once
+	zoneCountriesMap  map[string]string
+)
+
+// ZoneCountry returns the ISO 3166-1 alpha-2 code of the country the IANA
+// time zone name is in, e.g. "FR" for Europe/Paris, or "" if there is none,
+// e.g. for UTC.
+func ZoneCountry(name string) string {
+	return zoneCountries()[name]
+}
+
+// zoneCountries maps IANA time zones to ISO 3166-1 alpha-2 codes using the
+// bundled zone.tab, updated by the zone.tab, or failing that zone1970.tab, of
+// the first tzdata source that has one. Backward-compatible links, e.g.
+// Asia/Calcutta, go with their zone where tzdata.zi lists them. The map is
+// read once and shared.
+func zoneCountries() map[string]string {
+	zoneCountriesOnce.Do(func() {
+		zoneCountriesMap = readZoneTab(strings.NewReader(bundledZoneTab))
+
+	sources:
+		for _, src := range zoneinfoSources() {
+			for _, tab := range []string{"zone.tab", "zone1970.tab"} {
+				f, err := os.Open(filepath.Join(src, tab))
+				if err != nil {
+					continue
+				}
+
+				for zone, cc := range readZoneTab(f) {
+					zoneCountriesMap[zone] = cc
+				}
+				f.Close() // nolint: errcheck
+
+				// "L Asia/Kolkata Asia/Calcutta"
+				if f, err := os.Open(filepath.Join(src, "tzdata.zi")); err == nil {
+					s := bufio.NewScanner(f)
+					for s.Scan() {
+						l := strings.Fields(s.Text())
+						if len(l) == 3 && l[0] == "L" && zoneCountriesMap[l[2]] == "" && zoneCountriesMap[l[1]] != "" {
+							zoneCountriesMap[l[2]] = zoneCountriesMap[l[1]]
+						}
+					}
+					f.Close() // nolint: errcheck
+				}
+
+				break sources
+			}
+		}
+	})
+
+	return zoneCountriesMap
+}
+
+// readZoneTab reads "JP	+353916+1394441	Asia/Tokyo" lines, taking the first
+// of several country codes as zone1970.tab lists them, e.g. "CH,DE,LI".
+func readZoneTab(r io.Reader) map[string]string {
+	countries := make(map[string]string)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if strings.HasPrefix(s.Text(), "#") {
+			continue
+		}
+
+		f := strings.Split(s.Text(), "\t")
+		if len(f) < 3 {
+			continue
+		}
+
+		countries[f[2]] = strings.Split(f[0], ",")[0]
+	}
+
+	return countries
+}
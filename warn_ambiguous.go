@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tanakapayam/mtzdate/timetable"
+)
+
+// warnAmbiguous reports the zones whose city names match several places.
+// Naming the time zone, e.g. Portland:America/New_York, settles them.
+func warnAmbiguous(zones []timetable.Zone) {
+	for _, z := range zones {
+		if len(z.Rivals) > 0 {
+			log.Warn(ambiguity(z))
+		}
+	}
+}
+
+// ambiguity says which place z was resolved to and which it might have been.
+func ambiguity(z timetable.Zone) string {
+	var others []string
+	for _, city := range z.Rivals {
+		others = append(others, fmt.Sprintf("%s (%s)", city.Zone, city.Country))
+	}
+
+	return fmt.Sprintf("%q is ambiguous: using %s (%s) over %s; name the time zone to choose",
+		z.Label, z.Name, z.Country, strings.Join(others, ", "))
+}